@@ -87,6 +87,7 @@ import (
 	legacyevmtypes "github.com/xpladev/xpla/legacy/ethermint/x/evm/types"
 	legacyfeemarkettypes "github.com/xpladev/xpla/legacy/ethermint/x/feemarket/types"
 	xplaprecompile "github.com/xpladev/xpla/precompile"
+	burntypes "github.com/xpladev/xpla/x/burn/types"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -459,6 +460,8 @@ func (app *XplaApp) ModuleAccountAddrs() map[string]bool {
 func (app *XplaApp) BlockedModuleAccountAddrs(modAccAddrs map[string]bool) map[string]bool {
 	// remove module accounts that are ALLOWED to received funds
 	delete(modAccAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the burn schedules receive the community pool funds to burn
+	delete(modAccAddrs, authtypes.NewModuleAddress(burntypes.ModuleName).String())
 
 	// initialize precompile addresses to block
 	blockedPrecompilesHex := evmtypes.AvailableStaticPrecompiles
@@ -240,18 +240,11 @@ func NewAppKeeper(
 		govModAddress,
 	)
 
-	appKeepers.VolunteerKeeper = volunteerkeeper.NewKeeper(
-		runtime.NewKVStoreService(appKeepers.keys[volunteertypes.StoreKey]),
-		appCodec,
-		appKeepers.StakingKeeper,
-		appKeepers.DistrKeeper,
-		govModAddress,
-	)
-
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	appKeepers.StakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
+			appKeepers.StakingKeeper.VolunteerHooks(),
 			appKeepers.DistrKeeper.Hooks(),
 			appKeepers.SlashingKeeper.Hooks(),
 		),
@@ -529,12 +522,27 @@ func NewAppKeeper(
 		runtime.NewKVStoreService(appKeepers.keys[burntypes.StoreKey]),
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+		govModAddress,
+	)
+
+	// NOTE: the staking keeper holds the volunteer keeper by reference, so that
+	// it can be created after the reward and burn keepers
+	appKeepers.VolunteerKeeper = volunteerkeeper.NewKeeper(
+		runtime.NewKVStoreService(appKeepers.keys[volunteertypes.StoreKey]),
+		appCodec,
+		appKeepers.StakingKeeper,
+		appKeepers.DistrKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.RewardKeeper,
+		appKeepers.BurnKeeper,
 		govModAddress,
 	)
 
 	appKeepers.GovKeeper = appKeepers.GovKeeper.SetHooks(
 		govtypes.NewMultiGovHooks(
 			burnkeeper.NewGovHooksForBurn(appKeepers.BurnKeeper, appKeepers.BankKeeper, govkeeper.NewQueryServer(appKeepers.GovKeeper)),
+			volunteerkeeper.NewGovHooksForVolunteer(appKeepers.VolunteerKeeper, govkeeper.NewQueryServer(appKeepers.GovKeeper)),
 		),
 	)
 
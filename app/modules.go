@@ -79,6 +79,7 @@ var maccPerms = map[string][]string{
 	evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
 	feemarkettypes.ModuleName:      nil,
 	rewardtypes.ModuleName:         nil,
+	rewardtypes.ReserveEscrowName:  nil,
 	burntypes.ModuleName:           {authtypes.Burner},
 	banktypes.ModuleName:           nil,
 }
@@ -213,11 +214,14 @@ thus, staking.EndBlock must be executed before provider.EndBlock;
 - creating a new consumer chain requires the following order,
 CreateChildClient(), staking.EndBlock, provider.EndBlock;
 thus, gov.EndBlock must be executed before staking.EndBlock
+- volunteer.EndBlock unregisters the volunteer validators at the end of their
+terms, thus it must be executed before staking.EndBlock
 */
 func orderEndBlockers() []string {
 	return []string{
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
+		volunteertypes.ModuleName,
 		stakingtypes.ModuleName,
 		ibcexported.ModuleName,
 		ibctransfertypes.ModuleName,
@@ -240,7 +244,6 @@ func orderEndBlockers() []string {
 		evmtypes.ModuleName,
 		feemarkettypes.ModuleName,
 		rewardtypes.ModuleName,
-		volunteertypes.ModuleName,
 		burntypes.ModuleName,
 	}
 }
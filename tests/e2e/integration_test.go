@@ -29,6 +29,8 @@ import (
 	slashingtype "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtype "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	pstaking "github.com/cosmos/evm/precompiles/staking"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -39,6 +41,7 @@ import (
 	pwasm "github.com/xpladev/xpla/precompile/wasm"
 	xplatypes "github.com/xpladev/xpla/types"
 	xplabanktypes "github.com/xpladev/xpla/x/bank/types"
+	burntypes "github.com/xpladev/xpla/x/burn/types"
 	volunteerValType "github.com/xpladev/xpla/x/volunteer/types"
 
 	ethereum "github.com/ethereum/go-ethereum"
@@ -473,6 +476,261 @@ func (t *WASMIntegrationTestSuite) Test06_TotalSupplyCw20WithXplaBank() {
 
 }
 
+func (t *WASMIntegrationTestSuite) Test07_BurnCw20WithXplaBurn() {
+	// Prepare parameters
+	cw20ContractAddress := t.TokenAddress
+	denom := strings.Join([]string{xplabanktypes.CW20, cw20ContractAddress}, xplabanktypes.TYPE_SEPARATOR)
+	burnAmount := sdk.NewCoin(denom, sdkmath.NewInt(1))
+
+	ctx := context.Background()
+	bankClient := banktypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+	burnClient := burntypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+
+	balanceReq := &banktypes.QueryBalanceRequest{
+		Address: t.UserWallet1.StringAddress,
+		Denom:   denom,
+	}
+	balanceRes, err := bankClient.Balance(ctx, balanceReq)
+	assert.NoError(t.T(), err)
+	balance := balanceRes.Balance.Amount
+
+	supplyRes, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	assert.NoError(t.T(), err)
+	supply := supplyRes.Amount.Amount
+
+	totalBurnedRes, err := burnClient.TotalBurned(ctx, &burntypes.QueryTotalBurnedRequest{})
+	assert.NoError(t.T(), err)
+	totalBurned := totalBurnedRes.TotalBurned.AmountOf(denom)
+
+	// Burn cw20 with xplaburn
+	burnMsg := &burntypes.MsgBurnOwn{
+		Burner: t.UserWallet1.StringAddress,
+		Amount: sdk.NewCoins(burnAmount),
+	}
+
+	txhash, err := t.UserWallet1.SendTx(ChainID, burnMsg, false)
+	assert.NoError(t.T(), err)
+	assert.NotNil(t.T(), txhash)
+
+	err = txCheck(txhash)
+	assert.NoError(t.T(), err)
+
+	// balance and total supply should be decreased
+	balanceRes, err = bankClient.Balance(ctx, balanceReq)
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), balance.Sub(burnAmount.Amount), balanceRes.Balance.Amount)
+
+	supplyRes, err = bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), supply.Sub(burnAmount.Amount), supplyRes.Amount.Amount)
+
+	// burned amount should be tracked
+	totalBurnedRes, err = burnClient.TotalBurned(ctx, &burntypes.QueryTotalBurnedRequest{})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), totalBurned.Add(burnAmount.Amount), totalBurnedRes.TotalBurned.AmountOf(denom))
+}
+
+func (t *WASMIntegrationTestSuite) Test08_MultiSendCw20WithXplaBank() {
+	// Prepare parameters
+	cw20ContractAddress := t.TokenAddress
+	denom := strings.Join([]string{xplabanktypes.CW20, cw20ContractAddress}, xplabanktypes.TYPE_SEPARATOR)
+
+	ctx := context.Background()
+	client := banktypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+
+	recipients := []*WalletInfo{t.UserWallet2, t.ValidatorWallet1}
+	balances := make([]sdkmath.Int, len(recipients))
+	for i, recipient := range recipients {
+		res, err := client.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.StringAddress, Denom: denom})
+		assert.NoError(t.T(), err)
+		balances[i] = res.Balance.Amount
+	}
+
+	resNative, err := client.Balance(ctx, &banktypes.QueryBalanceRequest{Address: t.UserWallet2.StringAddress, Denom: xplatypes.DefaultDenom})
+	assert.NoError(t.T(), err)
+	nativeBalance := resNative.Balance.Amount
+
+	// MultiSend cw20 and native coins with xplabank
+	outputs := []banktypes.Output{
+		banktypes.NewOutput(t.UserWallet2.ByteAddress, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1)), sdk.NewCoin(xplatypes.DefaultDenom, sdkmath.NewInt(1)))),
+		banktypes.NewOutput(t.ValidatorWallet1.ByteAddress, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(2)))),
+	}
+	multiSendMsg := banktypes.NewMsgMultiSend(
+		banktypes.NewInput(t.UserWallet1.ByteAddress, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(3)), sdk.NewCoin(xplatypes.DefaultDenom, sdkmath.NewInt(1)))),
+		outputs,
+	)
+
+	txhash, err := t.UserWallet1.SendTx(ChainID, multiSendMsg, false)
+	assert.NoError(t.T(), err)
+	assert.NotNil(t.T(), txhash)
+
+	err = txCheck(txhash)
+	assert.NoError(t.T(), err)
+
+	// every recipient should receive its cw20 amount
+	for i, recipient := range recipients {
+		res, err := client.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.StringAddress, Denom: denom})
+		assert.NoError(t.T(), err)
+		assert.Equal(t.T(), balances[i].Add(outputs[i].Coins.AmountOf(denom)), res.Balance.Amount)
+	}
+
+	resNative, err = client.Balance(ctx, &banktypes.QueryBalanceRequest{Address: t.UserWallet2.StringAddress, Denom: xplatypes.DefaultDenom})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), nativeBalance.Add(sdkmath.NewInt(1)), resNative.Balance.Amount)
+}
+
+func (t *WASMIntegrationTestSuite) Test09_RegisterCw20AndQueryAllBalances() {
+	// Prepare parameters
+	cw20ContractAddress := t.TokenAddress
+	denom := strings.Join([]string{xplabanktypes.CW20, cw20ContractAddress}, xplabanktypes.TYPE_SEPARATOR)
+
+	ctx := context.Background()
+	bankClient := banktypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+	xplaBankClient := xplabanktypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+
+	// Register cw20 to the token registry
+	err := applyVoteTallyingProposal(
+		desc.GetConnectionWithContext(ctx),
+		[]sdk.Msg{xplabanktypes.NewMsgRegisterToken(t.GovAddress, denom)},
+		"register_cw20_token",
+		"Test cw20 token registration",
+		t.UserWallet1,
+		[]*WalletInfo{t.ValidatorWallet1, t.ValidatorWallet2, t.ValidatorWallet3, t.ValidatorWallet4},
+	)
+	assert.NoError(t.T(), err)
+
+	registeredRes, err := xplaBankClient.RegisteredTokens(ctx, &xplabanktypes.QueryRegisteredTokensRequest{})
+	assert.NoError(t.T(), err)
+	registered := false
+	for _, token := range registeredRes.Tokens {
+		if token.Denom == denom {
+			registered = true
+			assert.Positive(t.T(), token.MetadataHeight)
+		}
+	}
+	assert.True(t.T(), registered)
+
+	// the metadata of the cw20 contract should be cached into the denom metadata
+	rawQueryData, err := json.Marshal(map[string]any{"token_info": xplabanktypes.QueryMsg_TokenInfo{}})
+	assert.NoError(t.T(), err)
+
+	rawResponseData, err := wasmtype.NewQueryClient(desc.GetConnectionWithContext(ctx)).SmartContractState(ctx, &wasmtype.QuerySmartContractStateRequest{
+		Address:   cw20ContractAddress,
+		QueryData: rawQueryData,
+	})
+	assert.NoError(t.T(), err)
+
+	var tokenInfo xplabanktypes.TokenInfoResponse
+	err = json.Unmarshal(rawResponseData.Data, &tokenInfo)
+	assert.NoError(t.T(), err)
+
+	metadataRes, err := bankClient.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), denom, metadataRes.Metadata.Base)
+	assert.Equal(t.T(), tokenInfo.Name, metadataRes.Metadata.Name)
+	assert.Equal(t.T(), tokenInfo.Symbol, metadataRes.Metadata.Symbol)
+
+	// all balances should include the cw20 balance next to the native balances
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: t.UserWallet2.StringAddress, Denom: denom})
+	assert.NoError(t.T(), err)
+
+	nativeRes, err := bankClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: t.UserWallet2.StringAddress})
+	assert.NoError(t.T(), err)
+	assert.Zero(t.T(), nativeRes.Balances.AmountOf(denom).Int64())
+
+	allBalancesRes, err := xplaBankClient.AllBalances(ctx, &xplabanktypes.QueryAllBalancesRequest{Address: t.UserWallet2.StringAddress})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), balanceRes.Balance.Amount, allBalancesRes.Balances.AmountOf(denom))
+	assert.Equal(t.T(), nativeRes.Balances.AmountOf(xplatypes.DefaultDenom), allBalancesRes.Balances.AmountOf(xplatypes.DefaultDenom))
+}
+
+func (t *WASMIntegrationTestSuite) Test10_ApproveAndSendFromCw20WithXplaBank() {
+	// Prepare parameters
+	cw20ContractAddress := t.TokenAddress
+	denom := strings.Join([]string{xplabanktypes.CW20, cw20ContractAddress}, xplabanktypes.TYPE_SEPARATOR)
+
+	ctx := context.Background()
+	bankClient := banktypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+	xplaBankClient := xplabanktypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+
+	recipientRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: t.ValidatorWallet1.StringAddress, Denom: denom})
+	assert.NoError(t.T(), err)
+
+	// approve cw20 with xplabank
+	approveMsg := xplabanktypes.NewMsgApprove(t.UserWallet1.ByteAddress, t.UserWallet2.ByteAddress, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10))))
+	txhash, err := t.UserWallet1.SendTx(ChainID, approveMsg, false)
+	assert.NoError(t.T(), err)
+	assert.NotNil(t.T(), txhash)
+
+	err = txCheck(txhash)
+	assert.NoError(t.T(), err)
+
+	allowanceReq := &xplabanktypes.QueryAllowanceRequest{Owner: t.UserWallet1.StringAddress, Spender: t.UserWallet2.StringAddress, Denom: denom}
+	allowanceRes, err := xplaBankClient.Allowance(ctx, allowanceReq)
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), sdkmath.NewInt(10), allowanceRes.Allowance.Amount)
+
+	// send cw20 of the owner within the allowance
+	sendFromMsg := xplabanktypes.NewMsgSendFrom(t.UserWallet2.ByteAddress, t.UserWallet1.ByteAddress, t.ValidatorWallet1.ByteAddress, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(4))))
+	txhash, err = t.UserWallet2.SendTx(ChainID, sendFromMsg, false)
+	assert.NoError(t.T(), err)
+	assert.NotNil(t.T(), txhash)
+
+	err = txCheck(txhash)
+	assert.NoError(t.T(), err)
+
+	res, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: t.ValidatorWallet1.StringAddress, Denom: denom})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), recipientRes.Balance.Amount.Add(sdkmath.NewInt(4)), res.Balance.Amount)
+
+	allowanceRes, err = xplaBankClient.Allowance(ctx, allowanceReq)
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), sdkmath.NewInt(6), allowanceRes.Allowance.Amount)
+
+	// a zero amount revokes the allowance
+	approveMsg = xplabanktypes.NewMsgApprove(t.UserWallet1.ByteAddress, t.UserWallet2.ByteAddress, sdk.Coins{sdk.NewCoin(denom, sdkmath.ZeroInt())})
+	txhash, err = t.UserWallet1.SendTx(ChainID, approveMsg, false)
+	assert.NoError(t.T(), err)
+	assert.NotNil(t.T(), txhash)
+
+	err = txCheck(txhash)
+	assert.NoError(t.T(), err)
+
+	allowanceRes, err = xplaBankClient.Allowance(ctx, allowanceReq)
+	assert.NoError(t.T(), err)
+	assert.True(t.T(), allowanceRes.Allowance.Amount.IsZero())
+}
+
+func (t *WASMIntegrationTestSuite) Test11_ContractTokenStatusCw20WithXplaBank() {
+	// Prepare parameters
+	cw20ContractAddress := t.TokenAddress
+	denom := strings.Join([]string{xplabanktypes.CW20, cw20ContractAddress}, xplabanktypes.TYPE_SEPARATOR)
+
+	ctx := context.Background()
+	xplaBankClient := xplabanktypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+
+	// the cw20 contract should be compliant
+	res, err := xplaBankClient.ContractTokenStatus(ctx, &xplabanktypes.QueryContractTokenStatusRequest{ContractAddress: cw20ContractAddress})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), denom, res.Denom)
+	assert.True(t.T(), res.Compliant)
+	for _, check := range res.Checks {
+		assert.Empty(t.T(), check.Error, check.Method)
+	}
+
+	// an account without a contract is not
+	res, err = xplaBankClient.ContractTokenStatus(ctx, &xplabanktypes.QueryContractTokenStatusRequest{ContractAddress: t.UserWallet1.StringAddress})
+	assert.NoError(t.T(), err)
+	assert.False(t.T(), res.Compliant)
+
+	// a failing contract query is reported as an error
+	_, err = banktypes.NewQueryClient(desc.GetConnectionWithContext(ctx)).Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: t.UserWallet1.StringAddress,
+		Denom:   strings.Join([]string{xplabanktypes.CW20, t.UserWallet2.StringAddress}, xplabanktypes.TYPE_SEPARATOR),
+	})
+	assert.Error(t.T(), err)
+}
+
 func (t *WASMIntegrationTestSuite) Test12_GeneralVolunteerValidatorRegistryUnregistryDelegation() {
 	amt := sdkmath.NewInt(1000000000000000000)
 
@@ -2579,3 +2837,217 @@ func (t *EVMIntegrationTestSuite) Test11_NestedTransfer() {
 
 // 	assert.Equal(t.T(), new(big.Int).Add(amt, amt), resp)
 // }
+
+func (t *EVMIntegrationTestSuite) Test12_MultiSendErc20WithXplaBank() {
+	// Prepare parameters
+	erc20TokenContract := t.TokenAddress
+	denom := strings.Join([]string{xplabanktypes.ERC20, erc20TokenContract.String()}, xplabanktypes.TYPE_SEPARATOR)
+
+	ctx := context.Background()
+	client := banktypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+
+	recipients := []*EVMWalletInfo{t.UserWallet2, t.ValidatorWallet1}
+	balances := make([]sdkmath.Int, len(recipients))
+	for i, recipient := range recipients {
+		res, err := client.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.CosmosWalletInfo.ByteAddress.String(), Denom: denom})
+		assert.NoError(t.T(), err)
+		balances[i] = res.Balance.Amount
+	}
+
+	// MultiSend erc20 with xplabank
+	outputs := []banktypes.Output{
+		banktypes.NewOutput(t.UserWallet2.CosmosWalletInfo.ByteAddress, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1)))),
+		banktypes.NewOutput(t.ValidatorWallet1.CosmosWalletInfo.ByteAddress, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(2)))),
+	}
+	multiSendMsg := banktypes.NewMsgMultiSend(
+		banktypes.NewInput(t.UserWallet1.CosmosWalletInfo.ByteAddress, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(3)))),
+		outputs,
+	)
+
+	txhash, err := t.UserWallet1.CosmosWalletInfo.SendTx(ChainID, multiSendMsg, false)
+	assert.NoError(t.T(), err)
+	assert.NotNil(t.T(), txhash)
+
+	err = txCheck(txhash)
+	assert.NoError(t.T(), err)
+
+	// every recipient should receive its erc20 amount
+	tokenInterface, err := NewTokenInterface(t.TokenAddress, t.EthClient)
+	assert.NoError(t.T(), err)
+
+	for i, recipient := range recipients {
+		res, err := client.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.CosmosWalletInfo.ByteAddress.String(), Denom: denom})
+		assert.NoError(t.T(), err)
+		assert.Equal(t.T(), balances[i].Add(outputs[i].Coins.AmountOf(denom)), res.Balance.Amount)
+
+		// check with evm call
+		resp, err := tokenInterface.BalanceOf(&abibind.CallOpts{}, recipient.EthAddress)
+		assert.NoError(t.T(), err)
+		assert.Equal(t.T(), res.Balance.Amount.BigInt(), resp)
+	}
+}
+
+func (t *EVMIntegrationTestSuite) Test13_ApproveAndSendFromErc20WithXplaBank() {
+	// Prepare parameters
+	erc20TokenContract := t.TokenAddress
+	denom := strings.Join([]string{xplabanktypes.ERC20, erc20TokenContract.String()}, xplabanktypes.TYPE_SEPARATOR)
+
+	ctx := context.Background()
+	bankClient := banktypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+	xplaBankClient := xplabanktypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+
+	owner := t.UserWallet1.CosmosWalletInfo
+	spender := t.UserWallet2.CosmosWalletInfo
+	recipient := t.ValidatorWallet1.CosmosWalletInfo
+
+	recipientRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.ByteAddress.String(), Denom: denom})
+	assert.NoError(t.T(), err)
+
+	// approve erc20 with xplabank
+	approveMsg := xplabanktypes.NewMsgApprove(owner.ByteAddress, spender.ByteAddress, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10))))
+	txhash, err := owner.SendTx(ChainID, approveMsg, false)
+	assert.NoError(t.T(), err)
+	assert.NotNil(t.T(), txhash)
+
+	err = txCheck(txhash)
+	assert.NoError(t.T(), err)
+
+	allowanceReq := &xplabanktypes.QueryAllowanceRequest{Owner: owner.ByteAddress.String(), Spender: spender.ByteAddress.String(), Denom: denom}
+	allowanceRes, err := xplaBankClient.Allowance(ctx, allowanceReq)
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), sdkmath.NewInt(10), allowanceRes.Allowance.Amount)
+
+	// send erc20 of the owner within the allowance
+	sendFromMsg := xplabanktypes.NewMsgSendFrom(spender.ByteAddress, owner.ByteAddress, recipient.ByteAddress, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(4))))
+	txhash, err = spender.SendTx(ChainID, sendFromMsg, false)
+	assert.NoError(t.T(), err)
+	assert.NotNil(t.T(), txhash)
+
+	err = txCheck(txhash)
+	assert.NoError(t.T(), err)
+
+	res, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.ByteAddress.String(), Denom: denom})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), recipientRes.Balance.Amount.Add(sdkmath.NewInt(4)), res.Balance.Amount)
+
+	// check with evm call
+	tokenInterface, err := NewTokenInterface(t.TokenAddress, t.EthClient)
+	assert.NoError(t.T(), err)
+
+	resp, err := tokenInterface.Allowance(&abibind.CallOpts{}, t.UserWallet1.EthAddress, t.UserWallet2.EthAddress)
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), big.NewInt(6), resp)
+
+	allowanceRes, err = xplaBankClient.Allowance(ctx, allowanceReq)
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), sdkmath.NewInt(6), allowanceRes.Allowance.Amount)
+}
+
+func (t *EVMIntegrationTestSuite) Test14_ContractTokenStatusErc20WithXplaBank() {
+	// Prepare parameters
+	erc20TokenContract := t.TokenAddress
+	denom := strings.Join([]string{xplabanktypes.ERC20, erc20TokenContract.String()}, xplabanktypes.TYPE_SEPARATOR)
+
+	ctx := context.Background()
+	xplaBankClient := xplabanktypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+
+	// the erc20 contract should be compliant
+	res, err := xplaBankClient.ContractTokenStatus(ctx, &xplabanktypes.QueryContractTokenStatusRequest{ContractAddress: erc20TokenContract.Hex()})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), denom, res.Denom)
+	assert.True(t.T(), res.Compliant)
+	for _, check := range res.Checks {
+		assert.Empty(t.T(), check.Error, check.Method)
+	}
+
+	// an account without a contract is not
+	res, err = xplaBankClient.ContractTokenStatus(ctx, &xplabanktypes.QueryContractTokenStatusRequest{ContractAddress: t.UserWallet1.EthAddress.Hex()})
+	assert.NoError(t.T(), err)
+	assert.False(t.T(), res.Compliant)
+
+	// a failing contract query is reported as an error instead of a panic
+	_, err = banktypes.NewQueryClient(desc.GetConnectionWithContext(ctx)).Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: t.UserWallet1.CosmosWalletInfo.ByteAddress.String(),
+		Denom:   strings.Join([]string{xplabanktypes.ERC20, t.UserWallet2.EthAddress.Hex()}, xplabanktypes.TYPE_SEPARATOR),
+	})
+	assert.Error(t.T(), err)
+}
+
+func (t *EVMIntegrationTestSuite) Test15_BurnErc20WithXplaBurn() {
+	// Prepare parameters
+	erc20TokenContract := t.TokenAddress
+	denom := strings.Join([]string{xplabanktypes.ERC20, erc20TokenContract.String()}, xplabanktypes.TYPE_SEPARATOR)
+	burnAmount := sdk.NewCoin(denom, sdkmath.NewInt(1))
+
+	ctx := context.Background()
+	bankClient := banktypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+	burnClient := burntypes.NewQueryClient(desc.GetConnectionWithContext(ctx))
+
+	balanceReq := &banktypes.QueryBalanceRequest{
+		Address: t.UserWallet1.CosmosWalletInfo.ByteAddress.String(),
+		Denom:   denom,
+	}
+	balanceRes, err := bankClient.Balance(ctx, balanceReq)
+	assert.NoError(t.T(), err)
+	balance := balanceRes.Balance.Amount
+
+	deadBalanceReq := &banktypes.QueryBalanceRequest{
+		Address: sdk.AccAddress(xplabanktypes.Erc20DeadAddress.Bytes()).String(),
+		Denom:   denom,
+	}
+	deadBalanceRes, err := bankClient.Balance(ctx, deadBalanceReq)
+	assert.NoError(t.T(), err)
+	deadBalance := deadBalanceRes.Balance.Amount
+
+	supplyRes, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	assert.NoError(t.T(), err)
+	supply := supplyRes.Amount.Amount
+
+	totalBurnedRes, err := burnClient.TotalBurned(ctx, &burntypes.QueryTotalBurnedRequest{})
+	assert.NoError(t.T(), err)
+	totalBurned := totalBurnedRes.TotalBurned.AmountOf(denom)
+
+	// Burn erc20 with xplaburn
+	burnMsg := &burntypes.MsgBurnOwn{
+		Burner: t.UserWallet1.CosmosWalletInfo.StringAddress,
+		Amount: sdk.NewCoins(burnAmount),
+	}
+
+	txhash, err := t.UserWallet1.CosmosWalletInfo.SendTx(ChainID, burnMsg, false)
+	assert.NoError(t.T(), err)
+	assert.NotNil(t.T(), txhash)
+
+	err = txCheck(txhash)
+	assert.NoError(t.T(), err)
+
+	// the token contract does not implement burn, so the tokens are
+	// transferred to the dead address and the total supply stays the same
+	balanceRes, err = bankClient.Balance(ctx, balanceReq)
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), balance.Sub(burnAmount.Amount), balanceRes.Balance.Amount)
+
+	deadBalanceRes, err = bankClient.Balance(ctx, deadBalanceReq)
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), deadBalance.Add(burnAmount.Amount), deadBalanceRes.Balance.Amount)
+
+	supplyRes, err = bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), supply, supplyRes.Amount.Amount)
+
+	// the transfer to the dead address is reported
+	txRes, err := txtypes.NewServiceClient(desc.GetConnectionWithContext(ctx)).GetTx(ctx, &txtypes.GetTxRequest{Hash: txhash})
+	assert.NoError(t.T(), err)
+
+	deadAddressEventFound := false
+	for _, event := range txRes.TxResponse.Events {
+		if event.Type == proto.MessageName(&xplabanktypes.EventErc20TransferredToDeadAddress{}) {
+			deadAddressEventFound = true
+		}
+	}
+	assert.True(t.T(), deadAddressEventFound)
+
+	// burned amount should be tracked
+	totalBurnedRes, err = burnClient.TotalBurned(ctx, &burntypes.QueryTotalBurnedRequest{})
+	assert.NoError(t.T(), err)
+	assert.Equal(t.T(), totalBurned.Add(burnAmount.Amount), totalBurnedRes.TotalBurned.AmountOf(denom))
+}
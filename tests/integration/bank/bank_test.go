@@ -1,18 +1,50 @@
 package bank_test
 
 import (
+	"fmt"
+	"sort"
 	"testing"
 
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/gogoproto/proto"
+
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
 	"github.com/xpladev/xpla/tests/integration/testutil"
+	"github.com/xpladev/xpla/x/bank/keeper"
+	"github.com/xpladev/xpla/x/bank/types"
+	burntypes "github.com/xpladev/xpla/x/burn/types"
 )
 
 func TestCosmosBank(t *testing.T) {
 	input := testutil.CreateTestInput(t)
 
+	t.Run("send", func(t *testing.T) { testSend(t, &input) })
+	t.Run("token registry", func(t *testing.T) { testTokenRegistry(t, &input) })
+	t.Run("all balances", func(t *testing.T) { testAllBalances(t, &input) })
+	t.Run("allowance", func(t *testing.T) { testAllowance(t, &input) })
+	t.Run("contract queries", func(t *testing.T) { testContractQueries(t, &input) })
+	t.Run("input output coins", func(t *testing.T) { testInputOutputCoins(t, &input) })
+	t.Run("burn erc20", func(t *testing.T) { testBurnErc20(t, &input) })
+}
+
+func testSend(t *testing.T, input *testutil.TestInput) {
 	for i := 0; i < 2; i++ {
 		err := input.InitAccountWithCoins(sdk.AccAddress(testutil.Pks[i].Address()), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))))
 		assert.NoError(t, err)
@@ -32,3 +64,338 @@ func TestCosmosBank(t *testing.T) {
 	balance1 = input.BankKeeper.GetBalance(input.Ctx, sdk.AccAddress(testutil.Pks[1].Address()), sdk.DefaultBondDenom)
 	assert.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(101)), balance1)
 }
+
+// newCw20Denom returns the denom of a cw20 token whose contract does not exist.
+func newCw20Denom(index byte) string {
+	contractAddress := make([]byte, 32)
+	contractAddress[0] = index
+
+	return types.NewCw20Coin(sdk.AccAddress(contractAddress).String(), sdkmath.ZeroInt()).Denom
+}
+
+func testTokenRegistry(t *testing.T, input *testutil.TestInput) {
+	ctx, _ := input.Ctx.CacheContext()
+	msgServer := keeper.NewXplaMsgServerImpl(input.BankKeeper)
+	authority := input.BankKeeper.GetAuthority()
+
+	// only the governance can register a token
+	_, err := msgServer.RegisterToken(ctx, types.NewMsgRegisterToken(sdk.AccAddress(testutil.Pks[0].Address()).String(), newCw20Denom(1)))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// native coins and missing contracts cannot be registered
+	_, err = msgServer.RegisterToken(ctx, types.NewMsgRegisterToken(authority, sdk.DefaultBondDenom))
+	require.ErrorIs(t, err, types.ErrInvalidToken)
+
+	_, err = msgServer.RegisterToken(ctx, types.NewMsgRegisterToken(authority, newCw20Denom(1)))
+	require.ErrorIs(t, err, types.ErrInvalidToken)
+
+	_, err = msgServer.UnregisterToken(ctx, types.NewMsgUnregisterToken(authority, newCw20Denom(1)))
+	require.ErrorIs(t, err, types.ErrTokenNotRegistered)
+
+	// anyone can refresh the metadata, but only of a registered token
+	_, err = msgServer.RefreshTokenMetadata(ctx, types.NewMsgRefreshTokenMetadata(sdk.AccAddress(testutil.Pks[0].Address()).String(), newCw20Denom(1)))
+	require.ErrorIs(t, err, types.ErrTokenNotRegistered)
+
+	// the metadata of a token can be refreshed only once per interval
+	require.NoError(t, input.BankKeeper.SetRegisteredToken(ctx, types.NewRegisteredToken(newCw20Denom(1), ctx.BlockHeight())))
+	_, err = msgServer.RefreshTokenMetadata(ctx, types.NewMsgRefreshTokenMetadata(sdk.AccAddress(testutil.Pks[0].Address()).String(), newCw20Denom(1)))
+	require.ErrorIs(t, err, types.ErrTokenMetadataRefreshed)
+
+	refreshCtx := ctx.WithBlockHeight(ctx.BlockHeight() + types.TokenMetadataRefreshInterval)
+	_, err = msgServer.RefreshTokenMetadata(refreshCtx, types.NewMsgRefreshTokenMetadata(sdk.AccAddress(testutil.Pks[0].Address()).String(), newCw20Denom(1)))
+	require.ErrorIs(t, err, types.ErrInvalidToken)
+	require.NoError(t, input.BankKeeper.DeleteRegisteredToken(ctx, newCw20Denom(1)))
+
+	// tokens are kept in order and survive the genesis export
+	for _, index := range []byte{2, 1} {
+		require.NoError(t, input.BankKeeper.SetRegisteredToken(ctx, types.NewRegisteredToken(newCw20Denom(index), 0)))
+	}
+
+	expected := []types.RegisteredToken{
+		types.NewRegisteredToken(newCw20Denom(1), 0),
+		types.NewRegisteredToken(newCw20Denom(2), 0),
+	}
+	sort.Slice(expected, func(i, j int) bool { return expected[i].Denom < expected[j].Denom })
+
+	genesisState := input.BankKeeper.ExportTokenRegistryGenesis(ctx)
+	require.NoError(t, genesisState.Validate())
+	require.Equal(t, expected, genesisState.RegisteredTokens)
+
+	res, err := keeper.NewQuerier(input.BankKeeper).RegisteredTokens(ctx, &types.QueryRegisteredTokensRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, genesisState.RegisteredTokens[:1], res.Tokens)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	_, err = msgServer.RegisterToken(ctx, types.NewMsgRegisterToken(authority, newCw20Denom(1)))
+	require.ErrorIs(t, err, types.ErrTokenAlreadyRegistered)
+
+	// unregistering a token drops its cached metadata
+	input.BankKeeper.SetDenomMetaData(ctx, types.TokenMetadata{Name: "Token", Symbol: "TKN", Decimals: 6}.BankMetadata(newCw20Denom(1)))
+	_, err = msgServer.UnregisterToken(ctx, types.NewMsgUnregisterToken(authority, newCw20Denom(1)))
+	require.NoError(t, err)
+	require.False(t, input.BankKeeper.HasDenomMetaData(ctx, newCw20Denom(1)))
+
+	newCtx, _ := input.Ctx.CacheContext()
+	input.BankKeeper.InitTokenRegistryGenesis(newCtx, genesisState)
+	tokens, err := input.BankKeeper.GetRegisteredTokens(newCtx)
+	require.NoError(t, err)
+	require.Equal(t, genesisState.RegisteredTokens, tokens)
+}
+
+func testAllBalances(t *testing.T, input *testutil.TestInput) {
+	ctx, _ := input.Ctx.CacheContext()
+	addr := sdk.AccAddress(testutil.Pks[testutil.TempIndex].Address())
+	balances := sdk.NewCoins(
+		sdk.NewCoin("aaa", sdkmath.NewInt(1)),
+		sdk.NewCoin("bbb", sdkmath.NewInt(2)),
+		sdk.NewCoin("ccc", sdkmath.NewInt(3)),
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, "mint", balances))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, balances))
+
+	// a token whose contract fails to answer is reported as failed
+	failedDenom := newCw20Denom(1)
+	require.NoError(t, input.BankKeeper.SetRegisteredToken(ctx, types.NewRegisteredToken(failedDenom, 0)))
+
+	querier := keeper.NewQuerier(input.BankKeeper)
+	res, err := querier.AllBalances(ctx, &types.QueryAllBalancesRequest{Address: addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, balances, res.Balances)
+	require.Len(t, res.FailedTokens, 1)
+	require.Equal(t, failedDenom, res.FailedTokens[0].Denom)
+	require.NotEmpty(t, res.FailedTokens[0].Error)
+	require.Equal(t, uint64(4), res.Pagination.Total)
+
+	res, err = querier.AllBalances(ctx, &types.QueryAllBalancesRequest{Address: addr.String(), Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, balances[:2], res.Balances)
+	require.Empty(t, res.FailedTokens)
+	require.Equal(t, []byte("ccc"), res.Pagination.NextKey)
+
+	// only the token of the page is queried
+	res, err = querier.AllBalances(ctx, &types.QueryAllBalancesRequest{Address: addr.String(), Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, balances[2:], res.Balances)
+	require.Len(t, res.FailedTokens, 1)
+	require.Nil(t, res.Pagination.NextKey)
+
+	res, err = querier.AllBalances(ctx, &types.QueryAllBalancesRequest{Address: addr.String(), Pagination: &query.PageRequest{Offset: 2, Limit: 1, Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, balances[1:2], res.Balances)
+	require.Empty(t, res.FailedTokens)
+
+	_, err = querier.AllBalances(ctx, &types.QueryAllBalancesRequest{Address: addr.String(), Pagination: &query.PageRequest{Key: []byte("bbb"), Offset: 1}})
+	require.Error(t, err)
+}
+
+func testAllowance(t *testing.T, input *testutil.TestInput) {
+	ctx, _ := input.Ctx.CacheContext()
+	owner := sdk.AccAddress(testutil.Pks[0].Address())
+	spender := sdk.AccAddress(testutil.Pks[1].Address())
+	msgServer := keeper.NewXplaMsgServerImpl(input.BankKeeper)
+	querier := keeper.NewQuerier(input.BankKeeper)
+
+	// native coins have no allowance
+	_, err := querier.Allowance(ctx, &types.QueryAllowanceRequest{Owner: owner.String(), Spender: spender.String(), Denom: sdk.DefaultBondDenom})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = msgServer.Approve(ctx, types.NewMsgApprove(owner, spender, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1)))))
+	require.ErrorIs(t, err, types.ErrInvalidToken)
+
+	require.ErrorIs(t, input.BankKeeper.SendCoinsFrom(ctx, spender, owner, spender, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1)))), sdkerrors.ErrInvalidCoins)
+
+	// a missing contract fails without applying anything
+	_, err = querier.Allowance(ctx, &types.QueryAllowanceRequest{Owner: owner.String(), Spender: spender.String(), Denom: newCw20Denom(1)})
+	require.ErrorIs(t, err, types.ErrCw20Allowance)
+
+	_, err = msgServer.SendFrom(ctx, types.NewMsgSendFrom(spender, owner, spender, sdk.NewCoins(sdk.NewCoin(newCw20Denom(1), sdkmath.NewInt(1)))))
+	require.Error(t, err)
+
+	// tokens cannot be sent to blocked addresses
+	feeCollector := input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	_, err = msgServer.SendFrom(ctx, types.NewMsgSendFrom(spender, owner, feeCollector, sdk.NewCoins(sdk.NewCoin(newCw20Denom(1), sdkmath.NewInt(1)))))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// approving over an expired cw20 allowance grants an allowance that does
+	// not expire
+	cw20Denom := input.InstantiateCw20(t, ctx, owner, sdkmath.NewInt(100))
+	_, cw20Address := types.ParseDenom(cw20Denom)
+	increaseMsg := fmt.Sprintf(`{"increase_allowance":{"spender":"%s","amount":"5","expires":{"at_height":%d}}}`, spender, ctx.BlockHeight()+1)
+	_, err = wasmkeeper.NewDefaultPermissionKeeper(input.WasmKeeper).Execute(ctx, sdk.MustAccAddressFromBech32(cw20Address), owner, []byte(increaseMsg), nil)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = msgServer.Approve(ctx, types.NewMsgApprove(owner, spender, sdk.NewCoins(sdk.NewCoin(cw20Denom, sdkmath.NewInt(10)))))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	res, err := querier.Allowance(ctx, &types.QueryAllowanceRequest{Owner: owner.String(), Spender: spender.String(), Denom: cw20Denom})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(cw20Denom, sdkmath.NewInt(10)), res.Allowance)
+}
+
+func testContractQueries(t *testing.T, input *testutil.TestInput) {
+	ctx, _ := input.Ctx.CacheContext()
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	addr := sdk.AccAddress(testutil.Pks[0].Address())
+	denom := newCw20Denom(1)
+
+	// a failing contract is reported by a grpc status instead of a zero amount
+	_, err := input.BankKeeper.Balance(ctx, &banktypes.QueryBalanceRequest{Address: addr.String(), Denom: denom})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.ErrorContains(t, err, types.ErrCw20Balance.Error())
+
+	_, err = input.BankKeeper.SpendableBalanceByDenom(ctx, &banktypes.QuerySpendableBalanceByDenomRequest{Address: addr.String(), Denom: denom})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.ErrorContains(t, err, types.ErrCw20Balance.Error())
+
+	_, err = input.BankKeeper.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.ErrorContains(t, err, types.ErrCw20TokenInfo.Error())
+
+	// the keeper interface of the state machine never reads a failing
+	// contract as zero
+	require.Panics(t, func() { input.BankKeeper.GetSupply(ctx, denom) })
+	require.Panics(t, func() { input.BankKeeper.GetBalance(ctx, addr, denom) })
+
+	// an erc20 view call runs under the gas limit of contract queries
+	ctx = input.WithValidatorProposer(t, ctx)
+
+	loopDenom := deployLoop(t, ctx, input, addr)
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, err = input.BankKeeper.QueryBalance(ctx, addr, loopDenom)
+	require.ErrorIs(t, err, types.ErrErc20Balance)
+
+	// the evm stops at the limit, the rest is charged by the state reads
+	gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+	require.GreaterOrEqual(t, gasUsed, types.ContractQueryGasLimit)
+	require.Less(t, gasUsed, 2*types.ContractQueryGasLimit)
+
+	_, contractAddress := types.ParseDenom(denom)
+	querier := keeper.NewQuerier(input.BankKeeper)
+	res, err := querier.ContractTokenStatus(ctx, &types.QueryContractTokenStatusRequest{ContractAddress: contractAddress})
+	require.NoError(t, err)
+	require.Equal(t, denom, res.Denom)
+	require.False(t, res.Compliant)
+	require.Len(t, res.Checks, 3)
+	for _, check := range res.Checks {
+		require.NotEmpty(t, check.Error)
+	}
+
+	_, err = querier.ContractTokenStatus(ctx, &types.QueryContractTokenStatusRequest{ContractAddress: "0x1234"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = querier.ContractTokenStatus(ctx, &types.QueryContractTokenStatusRequest{ContractAddress: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// deployLoop deploys a contract whose code loops until it runs out of gas and
+// returns its denom.
+func deployLoop(t *testing.T, ctx sdk.Context, input *testutil.TestInput, deployer sdk.AccAddress) string {
+	// the init code returns the runtime code JUMPDEST PUSH1 0 JUMP
+	initCode := common.FromHex("635b6000566000526004601cf3")
+
+	from := common.BytesToAddress(deployer)
+	nonce, err := input.AccountKeeper.GetSequence(ctx, deployer)
+	require.NoError(t, err)
+
+	stateDB := statedb.New(ctx, input.EvmKeeper, statedb.NewEmptyTxConfig())
+	_, err = input.EvmKeeper.CallEVMWithData(ctx, stateDB, from, nil, initCode, true, false, nil)
+	require.NoError(t, err)
+
+	return types.NewErc20Coin(crypto.CreateAddress(from, nonce).Hex(), sdkmath.ZeroInt()).Denom
+}
+
+func testInputOutputCoins(t *testing.T, input *testutil.TestInput) {
+	ctx, _ := input.Ctx.CacheContext()
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	sender := sdk.AccAddress(testutil.Pks[0].Address())
+	recipients := []sdk.AccAddress{sdk.AccAddress(testutil.Pks[1].Address()), sdk.AccAddress(testutil.Pks[2].Address())}
+	require.NoError(t, input.InitAccountWithCoins(sender, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))))
+
+	ctx = input.WithValidatorProposer(t, ctx)
+
+	erc20Denom := input.DeployErc20(t, ctx, sender)
+	cw20Denom := input.InstantiateCw20(t, ctx, sender, sdkmath.NewInt(100))
+
+	amount := sdkmath.NewInt(10)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount), sdk.NewCoin(erc20Denom, amount), sdk.NewCoin(cw20Denom, amount))
+	balances := func(addr sdk.AccAddress) sdk.Coins {
+		result := sdk.NewCoins()
+		for _, coin := range coins {
+			result = result.Add(input.BankKeeper.GetBalance(ctx, addr, coin.Denom))
+		}
+		return result
+	}
+
+	senderBalances := balances(sender)
+	recipientBalances := []sdk.Coins{balances(recipients[0]), balances(recipients[1])}
+
+	// mixed native, erc20 and cw20 coins are sent to every output
+	require.NoError(t, input.BankKeeper.InputOutputCoins(ctx,
+		banktypes.NewInput(sender, coins.Add(coins...)),
+		[]banktypes.Output{banktypes.NewOutput(recipients[0], coins), banktypes.NewOutput(recipients[1], coins)},
+	))
+	require.Equal(t, senderBalances.Sub(coins.Add(coins...)...), balances(sender))
+	for i, recipient := range recipients {
+		require.Equal(t, recipientBalances[i].Add(coins...), balances(recipient))
+	}
+
+	// none of the transfers is applied if one of them fails, as the multi-send
+	// runs in the cache context of its transaction
+	senderBalances = balances(sender)
+	excessive := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount), sdk.NewCoin(erc20Denom, amount), sdk.NewCoin(cw20Denom, sdkmath.NewInt(1000)))
+	txCtx, _ := ctx.CacheContext()
+	err := input.BankKeeper.InputOutputCoins(txCtx,
+		banktypes.NewInput(sender, coins.Add(excessive...)),
+		[]banktypes.Output{banktypes.NewOutput(recipients[0], coins), banktypes.NewOutput(recipients[1], excessive)},
+	)
+	require.Error(t, err)
+	require.Equal(t, senderBalances, balances(sender))
+	for i, recipient := range recipients {
+		require.Equal(t, recipientBalances[i].Add(coins...), balances(recipient))
+	}
+}
+
+func testBurnErc20(t *testing.T, input *testutil.TestInput) {
+	ctx, _ := input.Ctx.CacheContext()
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx = input.WithValidatorProposer(t, ctx)
+
+	deployer := sdk.AccAddress(testutil.Pks[0].Address())
+	denom := input.DeployErc20(t, ctx, deployer)
+	amount := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, deployer, burntypes.ModuleName, amount))
+
+	// the token does not implement burn, so it is sent to the dead address
+	deadAddress := sdk.AccAddress(types.Erc20DeadAddress.Bytes())
+	require.NoError(t, input.BankKeeper.BurnCoins(ctx, burntypes.ModuleName, amount))
+	require.True(t, input.BankKeeper.GetBalance(ctx, input.AccountKeeper.GetModuleAddress(burntypes.ModuleName), denom).IsZero())
+	require.Equal(t, amount[0], input.BankKeeper.GetBalance(ctx, deadAddress, denom))
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		found = found || event.Type == proto.MessageName(&types.EventErc20TransferredToDeadAddress{})
+	}
+	require.True(t, found)
+
+	// burning more than the balance fails
+	require.Error(t, input.BankKeeper.BurnCoins(ctx, burntypes.ModuleName, amount))
+
+	// the token implements burn, so its supply is reduced. The failed
+	// transfer above used up the gas meter.
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	burnableDeployer := sdk.AccAddress(testutil.Pks[1].Address())
+	burnableDenom := input.DeployBurnableErc20(t, ctx, burnableDeployer, sdkmath.NewInt(100))
+	burnableAmount := sdk.NewCoins(sdk.NewCoin(burnableDenom, sdkmath.NewInt(10)))
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, burnableDeployer, burntypes.ModuleName, burnableAmount))
+
+	require.NoError(t, input.BankKeeper.BurnCoins(ctx, burntypes.ModuleName, burnableAmount))
+	require.True(t, input.BankKeeper.GetBalance(ctx, input.AccountKeeper.GetModuleAddress(burntypes.ModuleName), burnableDenom).IsZero())
+	require.True(t, input.BankKeeper.GetBalance(ctx, deadAddress, burnableDenom).IsZero())
+	require.Equal(t, sdkmath.NewInt(90), input.BankKeeper.GetSupply(ctx, burnableDenom).Amount)
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, proto.MessageName(&types.EventErc20TransferredToDeadAddress{}), event.Type)
+	}
+}
@@ -0,0 +1,526 @@
+package burn_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/xpladev/xpla/tests/integration/testutil"
+	banktypes "github.com/xpladev/xpla/x/bank/types"
+	"github.com/xpladev/xpla/x/burn"
+	"github.com/xpladev/xpla/x/burn/keeper"
+	"github.com/xpladev/xpla/x/burn/types"
+)
+
+// the wasm VM can only be created once per process, so the tests of this
+// package branch from a single test input
+var fixture testutil.TestInput
+
+func TestMain(m *testing.M) {
+	fixture = testutil.NewTestInput()
+
+	os.Exit(m.Run())
+}
+
+func TestBurnOwn(t *testing.T) {
+	input := fixture.Branch(t)
+	msgServer := keeper.NewMsgServerImpl(input.BurnKeeper)
+
+	burner := sdk.AccAddress(testutil.Pks[0].Address())
+	err := input.InitAccountWithCoins(burner, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))))
+	require.NoError(t, err)
+
+	supply := input.BankKeeper.GetSupply(input.Ctx, sdk.DefaultBondDenom)
+
+	burnAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(30)))
+	_, err = msgServer.BurnOwn(input.Ctx, &types.MsgBurnOwn{
+		Burner: burner.String(),
+		Amount: burnAmount,
+	})
+	require.NoError(t, err)
+
+	// burner balance & supply decreased
+	require.Equal(t, sdkmath.NewInt(70), input.BankKeeper.GetBalance(input.Ctx, burner, sdk.DefaultBondDenom).Amount)
+	require.Equal(t, supply.Amount.Sub(sdkmath.NewInt(30)), input.BankKeeper.GetSupply(input.Ctx, sdk.DefaultBondDenom).Amount)
+
+	// burn accounting
+	require.Equal(t, burnAmount, input.BurnKeeper.GetTotalBurned(input.Ctx))
+
+	_, err = msgServer.BurnOwn(input.Ctx, &types.MsgBurnOwn{
+		Burner: burner.String(),
+		Amount: burnAmount,
+	})
+	require.NoError(t, err)
+	require.Equal(t, burnAmount.Add(burnAmount...), input.BurnKeeper.GetTotalBurned(input.Ctx))
+
+	// insufficient funds
+	_, err = msgServer.BurnOwn(input.Ctx, &types.MsgBurnOwn{
+		Burner: burner.String(),
+		Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+	})
+	require.Error(t, err)
+
+	// invalid amount
+	_, err = msgServer.BurnOwn(input.Ctx, &types.MsgBurnOwn{
+		Burner: burner.String(),
+		Amount: sdk.Coins{},
+	})
+	require.Error(t, err)
+}
+
+func TestOngoingProposalsQuery(t *testing.T) {
+	input := fixture.Branch(t)
+	querier := keeper.Querier{Keeper: input.BurnKeeper}
+
+	proposer0 := sdk.AccAddress(testutil.Pks[0].Address()).String()
+	proposer1 := sdk.AccAddress(testutil.Pks[1].Address()).String()
+
+	proposals := []types.BurnProposal{
+		{ProposalId: 1, Proposer: proposer0, Amount: sdk.NewCoins(sdk.NewCoin("axpla", sdkmath.NewInt(1)))},
+		{ProposalId: 2, Proposer: proposer1, Amount: sdk.NewCoins(sdk.NewCoin("axpla", sdkmath.NewInt(2)))},
+		{ProposalId: 3, Proposer: proposer0, Amount: sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(3)))},
+	}
+	for _, proposal := range proposals {
+		require.NoError(t, input.BurnKeeper.OngoingBurnProposals.Set(input.Ctx, proposal.ProposalId, proposal))
+	}
+
+	testCases := []struct {
+		name     string
+		req      *types.QueryOngoingProposalsRequest
+		expected []uint64
+		expErr   bool
+	}{
+		{"all", &types.QueryOngoingProposalsRequest{}, []uint64{1, 2, 3}, false},
+		{"by proposer", &types.QueryOngoingProposalsRequest{Proposer: proposer0}, []uint64{1, 3}, false},
+		{"by denom", &types.QueryOngoingProposalsRequest{Denom: "axpla"}, []uint64{1, 2}, false},
+		{"by proposer and denom", &types.QueryOngoingProposalsRequest{Proposer: proposer0, Denom: "stake"}, []uint64{3}, false},
+		{"paginated", &types.QueryOngoingProposalsRequest{Pagination: &query.PageRequest{Limit: 2}}, []uint64{1, 2}, false},
+		{"invalid proposer", &types.QueryOngoingProposalsRequest{Proposer: "invalid"}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := querier.OngoingProposals(input.Ctx, tc.req)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ids := []uint64{}
+			for _, proposal := range res.Proposals {
+				ids = append(ids, proposal.ProposalId)
+			}
+			require.Equal(t, tc.expected, ids)
+		})
+	}
+}
+
+func TestBurnSchedule(t *testing.T) {
+	input := fixture.Branch(t)
+	msgServer := keeper.NewMsgServerImpl(input.BurnKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	funder := sdk.AccAddress(testutil.Pks[2].Address())
+	poolAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+	require.NoError(t, input.InitAccountWithCoins(funder, poolAmount))
+	require.NoError(t, input.DistrKeeper.FundCommunityPool(input.Ctx, poolAmount, funder))
+
+	totalBurned := input.BurnKeeper.GetTotalBurned(input.Ctx)
+	supply := input.BankKeeper.GetSupply(input.Ctx, sdk.DefaultBondDenom)
+	burnModuleBalance := input.BankKeeper.GetAllBalances(input.Ctx, authtypes.NewModuleAddress(types.ModuleName))
+	communityPoolBefore, err := input.DistrKeeper.FeePool.Get(input.Ctx)
+	require.NoError(t, err)
+
+	amountPerBurn := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(40)))
+	totalAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	// the denom is not allowed to burn
+	_, err = msgServer.CreateBurnSchedule(input.Ctx, &types.MsgCreateBurnSchedule{
+		Authority:      authority,
+		AmountPerBurn:  amountPerBurn,
+		IntervalBlocks: 10,
+		TotalAmount:    totalAmount,
+	})
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+
+	require.NoError(t, input.BurnKeeper.SetParams(input.Ctx, types.Params{
+		AllowedDenoms:  []string{sdk.DefaultBondDenom},
+		MaxBurnAmounts: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(40))),
+	}))
+
+	// the amount per burn exceeds the max burn amount
+	_, err = msgServer.CreateBurnSchedule(input.Ctx, &types.MsgCreateBurnSchedule{
+		Authority:      authority,
+		AmountPerBurn:  amountPerBurn.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.OneInt())),
+		IntervalBlocks: 10,
+		TotalAmount:    totalAmount,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidBurnAmount)
+
+	// only governance can create a schedule
+	_, err = msgServer.CreateBurnSchedule(input.Ctx, &types.MsgCreateBurnSchedule{
+		Authority:      funder.String(),
+		AmountPerBurn:  amountPerBurn,
+		IntervalBlocks: 10,
+		TotalAmount:    totalAmount,
+	})
+	require.Error(t, err)
+
+	// zero interval
+	_, err = msgServer.CreateBurnSchedule(input.Ctx, &types.MsgCreateBurnSchedule{
+		Authority:     authority,
+		AmountPerBurn: amountPerBurn,
+		TotalAmount:   totalAmount,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidBurnSchedule)
+
+	res, err := msgServer.CreateBurnSchedule(input.Ctx, &types.MsgCreateBurnSchedule{
+		Authority:      authority,
+		AmountPerBurn:  amountPerBurn,
+		IntervalBlocks: 10,
+		TotalAmount:    totalAmount,
+	})
+	require.NoError(t, err)
+
+	ctx := input.Ctx
+	startHeight := ctx.BlockHeight()
+	querier := keeper.Querier{Keeper: input.BurnKeeper}
+
+	// not due yet
+	ctx = ctx.WithBlockHeight(startHeight + 9)
+	require.NoError(t, burn.EndBlocker(ctx, input.BurnKeeper))
+	require.Equal(t, totalBurned, input.BurnKeeper.GetTotalBurned(ctx))
+
+	// 40 + 40 + 20
+	for i, expected := range []int64{40, 80, 100} {
+		ctx = ctx.WithBlockHeight(startHeight + int64(i+1)*10)
+		require.NoError(t, burn.EndBlocker(ctx, input.BurnKeeper))
+
+		burned := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(expected)))
+		require.Equal(t, totalBurned.Add(burned...), input.BurnKeeper.GetTotalBurned(ctx))
+		require.Equal(t, supply.Amount.SubRaw(expected), input.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount)
+	}
+
+	// every execution is recorded and the burn module account keeps nothing
+	historyRes, err := querier.BurnScheduleHistory(ctx, &types.QueryBurnScheduleHistoryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.BurnScheduleHistory{
+		{ScheduleId: res.ScheduleId, Height: startHeight + 10, Amount: amountPerBurn},
+		{ScheduleId: res.ScheduleId, Height: startHeight + 20, Amount: amountPerBurn},
+		{ScheduleId: res.ScheduleId, Height: startHeight + 30, Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(20)))},
+	}, historyRes.Histories)
+	require.Equal(t, burnModuleBalance, input.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)))
+
+	// the community pool funds are deducted
+	communityPool, err := input.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, communityPoolBefore.CommunityPool.Sub(sdk.NewDecCoinsFromCoins(totalAmount...)), communityPool.CommunityPool)
+
+	// completed schedule is removed
+	has, err := input.BurnKeeper.BurnSchedules.Has(ctx, res.ScheduleId)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// cancel
+	res, err = msgServer.CreateBurnSchedule(ctx, &types.MsgCreateBurnSchedule{
+		Authority:      authority,
+		AmountPerBurn:  amountPerBurn,
+		IntervalBlocks: 10,
+		TotalAmount:    totalAmount,
+	})
+	require.NoError(t, err)
+
+	schedules, err := querier.BurnSchedules(ctx, &types.QueryBurnSchedulesRequest{})
+	require.NoError(t, err)
+	require.Len(t, schedules.Schedules, 1)
+	require.Equal(t, res.ScheduleId, schedules.Schedules[0].Id)
+
+	_, err = msgServer.CancelBurnSchedule(ctx, &types.MsgCancelBurnSchedule{Authority: authority, ScheduleId: res.ScheduleId})
+	require.NoError(t, err)
+
+	_, err = msgServer.CancelBurnSchedule(ctx, &types.MsgCancelBurnSchedule{Authority: authority, ScheduleId: res.ScheduleId})
+	require.ErrorIs(t, err, types.ErrBurnScheduleNotFound)
+}
+
+func TestParams(t *testing.T) {
+	input := fixture.Branch(t)
+	msgServer := keeper.NewMsgServerImpl(input.BurnKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	params := types.Params{
+		AllowedDenoms:      []string{sdk.DefaultBondDenom},
+		MaxBurnAmounts:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))),
+		MinProposerBalance: sdk.NewCoins(),
+	}
+
+	// only governance can update params
+	_, err := msgServer.UpdateParams(input.Ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress(testutil.Pks[0].Address()).String(),
+		Params:    params,
+	})
+	require.Error(t, err)
+
+	// invalid params
+	_, err = msgServer.UpdateParams(input.Ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    types.Params{AllowedDenoms: []string{sdk.DefaultBondDenom, sdk.DefaultBondDenom}},
+	})
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(input.Ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	res, err := keeper.Querier{Keeper: input.BurnKeeper}.Params(input.Ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params.AllowedDenoms, res.Params.AllowedDenoms)
+	require.Equal(t, params.MaxBurnAmounts, res.Params.MaxBurnAmounts)
+	require.True(t, res.Params.MinProposerBalance.IsZero())
+
+	// escrow coins in the burn module as a submitted burn proposal would
+	escrower := sdk.AccAddress(testutil.Pks[3].Address())
+	escrowAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)), sdk.NewCoin("uother", sdkmath.NewInt(100)))
+	require.NoError(t, input.InitAccountWithCoins(escrower, escrowAmount))
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(input.Ctx, escrower, types.ModuleName, escrowAmount))
+
+	// not allowed denom
+	_, err = msgServer.Burn(input.Ctx, &types.MsgBurn{
+		Authority: authority,
+		Amount:    sdk.NewCoins(sdk.NewCoin("uother", sdkmath.NewInt(10))),
+	})
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+
+	// exceeds max burn amount
+	_, err = msgServer.Burn(input.Ctx, &types.MsgBurn{
+		Authority: authority,
+		Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(51))),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidBurnAmount)
+
+	_, err = msgServer.Burn(input.Ctx, &types.MsgBurn{
+		Authority: authority,
+		Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))),
+	})
+	require.NoError(t, err)
+}
+
+func TestMultiMsgBurnProposal(t *testing.T) {
+	input := fixture.Branch(t)
+	hooks := keeper.NewGovHooksForBurn(input.BurnKeeper, input.BankKeeper, govkeeper.NewQueryServer(input.GovKeeper))
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	burnModuleAddress := authtypes.NewModuleAddress(types.ModuleName)
+
+	require.NoError(t, input.BurnKeeper.SetParams(input.Ctx, types.Params{
+		AllowedDenoms:  []string{sdk.DefaultBondDenom},
+		MaxBurnAmounts: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60))),
+	}))
+
+	proposer := sdk.AccAddress(testutil.Pks[4].Address())
+	initialBalance := sdkmath.NewInt(1000)
+	require.NoError(t, input.InitAccountWithCoins(proposer, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initialBalance))))
+
+	msgs := []sdk.Msg{
+		&types.MsgBurn{Authority: authority, Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(30)))},
+		&types.MsgBurn{Authority: authority, Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(20)))},
+	}
+	totalAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)))
+
+	submit := func() uint64 {
+		burnModuleBalance := input.BankKeeper.GetBalance(input.Ctx, burnModuleAddress, sdk.DefaultBondDenom).Amount
+
+		input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+		proposal, err := input.GovKeeper.SubmitProposal(input.Ctx, msgs, "", "burn", "burn", proposer, false)
+		require.NoError(t, err)
+		requireTypedEvent(t, input.Ctx, &types.EventBurnEscrowed{ProposalId: proposal.Id, Proposer: proposer.String(), Amount: totalAmount})
+
+		// the whole amount of all messages is escrowed and recorded
+		burnProposal, err := input.BurnKeeper.OngoingBurnProposals.Get(input.Ctx, proposal.Id)
+		require.NoError(t, err)
+		require.Equal(t, totalAmount, burnProposal.Amount)
+		require.Equal(t, initialBalance.Sub(totalAmount.AmountOf(sdk.DefaultBondDenom)), input.BankKeeper.GetBalance(input.Ctx, proposer, sdk.DefaultBondDenom).Amount)
+		require.Equal(t, burnModuleBalance.Add(totalAmount.AmountOf(sdk.DefaultBondDenom)), input.BankKeeper.GetBalance(input.Ctx, burnModuleAddress, sdk.DefaultBondDenom).Amount)
+
+		return proposal.Id
+	}
+
+	setStatus := func(proposalID uint64, status govv1types.ProposalStatus) {
+		proposal, err := input.GovKeeper.Proposals.Get(input.Ctx, proposalID)
+		require.NoError(t, err)
+		proposal.Status = status
+		require.NoError(t, input.GovKeeper.SetProposal(input.Ctx, proposal))
+	}
+
+	// failed min deposit refunds the whole escrow
+	proposalID := submit()
+	input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, hooks.AfterProposalFailedMinDeposit(input.Ctx, proposalID))
+	require.Equal(t, initialBalance, input.BankKeeper.GetBalance(input.Ctx, proposer, sdk.DefaultBondDenom).Amount)
+	requireTypedEvent(t, input.Ctx, &types.EventBurnRefunded{ProposalId: proposalID, Proposer: proposer.String(), Amount: totalAmount})
+	has, err := input.BurnKeeper.OngoingBurnProposals.Has(input.Ctx, proposalID)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// rejected proposal refunds the whole escrow
+	proposalID = submit()
+	setStatus(proposalID, govv1types.StatusRejected)
+	input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(input.Ctx, proposalID))
+	require.Equal(t, initialBalance, input.BankKeeper.GetBalance(input.Ctx, proposer, sdk.DefaultBondDenom).Amount)
+	requireTypedEvent(t, input.Ctx, &types.EventBurnRefunded{ProposalId: proposalID, Proposer: proposer.String(), Amount: totalAmount})
+
+	// passed proposal burns each message and records the whole escrow with
+	// a single typed event
+	proposalID = submit()
+	msgServer := keeper.NewMsgServerImpl(input.BurnKeeper)
+	for _, msg := range msgs {
+		input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+		_, err := msgServer.Burn(input.Ctx, msg.(*types.MsgBurn))
+		require.NoError(t, err)
+		requireTypedEvent(t, input.Ctx, &types.EventBurn{Authority: authority, Amount: msg.(*types.MsgBurn).Amount})
+	}
+	input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	setStatus(proposalID, govv1types.StatusPassed)
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(input.Ctx, proposalID))
+	for _, event := range input.Ctx.EventManager().Events() {
+		require.NotEqual(t, proto.MessageName(&types.EventBurn{}), event.Type)
+	}
+	history, err := input.BurnKeeper.BurnHistories.Get(input.Ctx, proposalID)
+	require.NoError(t, err)
+	require.Equal(t, totalAmount, history.Amount)
+	requireTypedEvent(t, input.Ctx, &types.EventBurnExecuted{ProposalId: proposalID, Proposer: proposer.String(), Amount: totalAmount})
+
+	// the max burn amount applies to the sum of all messages
+	msgs = append(msgs, &types.MsgBurn{Authority: authority, Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(20)))})
+	_, err = input.GovKeeper.SubmitProposal(input.Ctx, msgs, "", "burn", "burn", proposer, false)
+	require.ErrorIs(t, err, types.ErrInvalidBurnAmount)
+}
+
+func TestTokenBurnProposal(t *testing.T) {
+	input := fixture.Branch(t)
+	input.Ctx = input.WithValidatorProposer(t, input.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+
+	hooks := keeper.NewGovHooksForBurn(input.BurnKeeper, input.BankKeeper, govkeeper.NewQueryServer(input.GovKeeper))
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	proposer := sdk.AccAddress(testutil.Pks[6].Address())
+	burnableDeployer := sdk.AccAddress(testutil.Pks[7].Address())
+	for _, addr := range []sdk.AccAddress{proposer, burnableDeployer} {
+		input.AccountKeeper.SetAccount(input.Ctx, input.AccountKeeper.NewAccountWithAddress(input.Ctx, addr))
+	}
+	erc20Denom := input.DeployErc20(t, input.Ctx, proposer)
+	burnableDenom := input.DeployBurnableErc20(t, input.Ctx, burnableDeployer, sdkmath.NewInt(100))
+	cw20Denom := input.InstantiateCw20(t, input.Ctx, proposer, sdkmath.NewInt(100))
+	require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, burnableDeployer, proposer, sdk.NewCoins(sdk.NewCoin(burnableDenom, sdkmath.NewInt(100)))))
+
+	require.NoError(t, input.BurnKeeper.SetParams(input.Ctx, types.Params{
+		AllowedDenoms: []string{erc20Denom, burnableDenom, cw20Denom},
+	}))
+
+	amount := sdk.NewCoins(
+		sdk.NewCoin(erc20Denom, sdkmath.NewInt(10)),
+		sdk.NewCoin(burnableDenom, sdkmath.NewInt(10)),
+		sdk.NewCoin(cw20Denom, sdkmath.NewInt(10)),
+	)
+	msg := &types.MsgBurn{Authority: authority, Amount: amount}
+
+	// the tokens are escrowed in the burn module account on submission
+	proposal, err := input.GovKeeper.SubmitProposal(input.Ctx, []sdk.Msg{msg}, "", "burn", "burn", proposer, false)
+	require.NoError(t, err)
+	for _, coin := range amount {
+		require.Equal(t, coin, input.BankKeeper.GetBalance(input.Ctx, authtypes.NewModuleAddress(types.ModuleName), coin.Denom))
+	}
+
+	// the passed proposal burns every token
+	_, err = keeper.NewMsgServerImpl(input.BurnKeeper).Burn(input.Ctx, msg)
+	require.NoError(t, err)
+
+	proposal.Status = govv1types.StatusPassed
+	require.NoError(t, input.GovKeeper.SetProposal(input.Ctx, proposal))
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(input.Ctx, proposal.Id))
+
+	totalBurned := input.BurnKeeper.GetTotalBurned(input.Ctx)
+	for _, coin := range amount {
+		require.True(t, input.BankKeeper.GetBalance(input.Ctx, authtypes.NewModuleAddress(types.ModuleName), coin.Denom).IsZero())
+		require.Equal(t, coin.Amount, totalBurned.AmountOf(coin.Denom))
+	}
+
+	history, err := input.BurnKeeper.BurnHistories.Get(input.Ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, amount, history.Amount)
+
+	// the erc20 token without burn is sent to the dead address, and the
+	// others reduce their supply
+	deadAddress := sdk.AccAddress(banktypes.Erc20DeadAddress.Bytes())
+	require.Equal(t, sdkmath.NewInt(10), input.BankKeeper.GetBalance(input.Ctx, deadAddress, erc20Denom).Amount)
+	require.True(t, input.BankKeeper.GetBalance(input.Ctx, deadAddress, burnableDenom).IsZero())
+	require.Equal(t, sdkmath.NewInt(90), input.BankKeeper.GetSupply(input.Ctx, burnableDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(90), input.BankKeeper.GetSupply(input.Ctx, cw20Denom).Amount)
+}
+
+// requireTypedEvent requires the given typed event to be the only event of
+// its type emitted to the context
+func requireTypedEvent(t *testing.T, ctx sdk.Context, expected proto.Message) {
+	var found []proto.Message
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(expected) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		found = append(found, msg)
+	}
+	require.Equal(t, []proto.Message{expected}, found)
+}
+
+func TestGenesis(t *testing.T) {
+	input := fixture.Branch(t)
+	ctx := input.Ctx
+
+	proposer := sdk.AccAddress(testutil.Pks[5].Address()).String()
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	require.NoError(t, input.BurnKeeper.OngoingBurnProposals.Set(ctx, 100, types.BurnProposal{ProposalId: 100, Proposer: proposer, Amount: amount}))
+	require.NoError(t, input.BurnKeeper.AddTotalBurned(ctx, amount))
+	require.NoError(t, input.BurnKeeper.BurnHistories.Set(ctx, 101, types.BurnHistory{ProposalId: 101, Height: ctx.BlockHeight(), Amount: amount}))
+	scheduleId, err := input.BurnKeeper.CreateBurnSchedule(ctx, amount, 10, amount.Add(amount...))
+	require.NoError(t, err)
+	require.NoError(t, input.BurnKeeper.BurnScheduleHistories.Set(ctx, collections.Join(scheduleId, ctx.BlockHeight()), types.BurnScheduleHistory{ScheduleId: scheduleId, Height: ctx.BlockHeight(), Amount: amount}))
+
+	genesis := input.BurnKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.NotEmpty(t, genesis.OngoingBurnProposals)
+	require.NotEmpty(t, genesis.TotalBurned)
+	require.NotEmpty(t, genesis.BurnHistories)
+	require.NotEmpty(t, genesis.BurnSchedules)
+	require.NotEmpty(t, genesis.BurnScheduleHistories)
+
+	// import into an empty burn store
+	importCtx, _ := input.Ctx.CacheContext()
+	require.NoError(t, input.BurnKeeper.OngoingBurnProposals.Clear(importCtx, nil))
+	require.NoError(t, input.BurnKeeper.TotalBurned.Clear(importCtx, nil))
+	require.NoError(t, input.BurnKeeper.BurnHistories.Clear(importCtx, nil))
+	require.NoError(t, input.BurnKeeper.BurnSchedules.Clear(importCtx, nil))
+	require.NoError(t, input.BurnKeeper.BurnScheduleHistories.Clear(importCtx, nil))
+
+	input.BurnKeeper.InitGenesis(importCtx, genesis)
+	require.Equal(t, genesis, input.BurnKeeper.ExportGenesis(importCtx))
+}
@@ -1,30 +1,39 @@
 package reward_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/xpladev/xpla/tests/integration/testutil"
 	"github.com/xpladev/xpla/x/reward"
+	"github.com/xpladev/xpla/x/reward/keeper"
+	rewardtypes "github.com/xpladev/xpla/x/reward/types"
 )
 
-// TestBeginBlocker
+// setupDistribution branches the fixture with the state of the begin blocker
+// tests
 // 1. 10 validator & 100 self delegation
 // 2. validator settlement have 100 & delegation 10, each validator
 // 3. 1.1 fee
-// 4. process 1 block
-func TestBeginBlocker(t *testing.T) {
-	input := testutil.CreateTestInput(t)
+func setupDistribution(t *testing.T) testutil.TestInput {
+	input := fixture.Branch(t)
 	input.StakingHandler.Commission = stakingtypes.NewCommissionRates(sdkmath.LegacyNewDecWithPrec(10, 2), sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec())
 
 	sdk.DefaultPowerReduction = sdkmath.NewIntFromUint64(1000000000000000000)
@@ -100,18 +109,37 @@ func TestBeginBlocker(t *testing.T) {
 	}
 	input.Ctx = input.Ctx.WithVoteInfos(voteInfos)
 
-	distribution.BeginBlocker(input.Ctx, input.DistrKeeper)
-	reward.BeginBlocker(input.Ctx, input.RewardKeeper, input.BankKeeper, input.StakingKeeper, input.DistrKeeper)
+	return input
+}
+
+// runBeginBlockers processes 1 block
+func runBeginBlockers(t *testing.T, input testutil.TestInput) {
+	require.NoError(t, distribution.BeginBlocker(input.Ctx, input.DistrKeeper))
+	require.NoError(t, reward.BeginBlocker(input.Ctx, input.RewardKeeper, input.BankKeeper, input.StakingKeeper, input.DistrKeeper))
+}
+
+// poolBalance returns the fee pool share of the 1.1 fee (0.018)
+func poolBalance() sdkmath.LegacyDec {
+	decPoolBalance, _ := sdkmath.LegacyNewDecFromStr("0.018")
+	return decPoolBalance.MulInt(sdk.DefaultPowerReduction)
+}
+
+// TestBeginBlocker
+// 1. 10 validator & 100 self delegation
+// 2. validator settlement have 100 & delegation 10, each validator
+// 3. 1.1 fee
+// 4. process 1 block
+func TestBeginBlocker(t *testing.T) {
+	input := setupDistribution(t)
+	runBeginBlockers(t, input)
 
 	// check result
 
 	// 1. reward module account (0.018)
-	decPoolBalance, _ := sdkmath.LegacyNewDecFromStr("0.018")
-	poolBalance := decPoolBalance.MulInt(sdk.DefaultPowerReduction)
 	uBlockPerYear, err := input.RewardKeeper.GetBlocksPerYear(input.Ctx)
 	require.NoError(t, err)
 	blockPerYear := int64(uBlockPerYear)
-	remainPoolBalance := poolBalance.MulInt64(blockPerYear - 1).QuoInt64(blockPerYear).Ceil()
+	remainPoolBalance := poolBalance().MulInt64(blockPerYear - 1).QuoInt64(blockPerYear).Ceil()
 	require.Equal(
 		t, remainPoolBalance.TruncateInt(),
 		input.RewardKeeper.PoolBalances(input.Ctx)[0].Amount,
@@ -132,3 +160,297 @@ func TestBeginBlocker(t *testing.T) {
 		input.BankKeeper.GetAllBalances(input.Ctx, sdk.AccAddress(testutil.Pks[testutil.ReserveIndex].Address())).String(),
 	)
 }
+
+func TestDistributionHistory(t *testing.T) {
+	input := setupDistribution(t)
+
+	rewardParams, err := input.RewardKeeper.GetParams(input.Ctx)
+	require.NoError(t, err)
+	rewardParams.DistributionHistoryLimit = 3
+	require.NoError(t, input.RewardKeeper.SetParams(input.Ctx, rewardParams))
+
+	runBeginBlockers(t, input)
+
+	// the record of the block is stored and emitted
+	uBlockPerYear, err := input.RewardKeeper.GetBlocksPerYear(input.Ctx)
+	require.NoError(t, err)
+	blockPerYear := int64(uBlockPerYear)
+	remainPoolBalance := poolBalance().MulInt64(blockPerYear - 1).QuoInt64(blockPerYear).Ceil()
+
+	res, err := input.DistrKeeper.FeePool.Get(input.Ctx)
+	require.NoError(t, err)
+	communityPool, _ := res.CommunityPool.TruncateDecimal()
+
+	expectedRecord := rewardtypes.DistributionRecord{
+		Height:        input.Ctx.BlockHeight(),
+		FeePool:       sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, poolBalance().TruncateInt())),
+		CommunityPool: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, communityPool.AmountOf(sdk.DefaultBondDenom))),
+		Reserve:       sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(900000000000000))),
+		FeeCollector:  sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, poolBalance().TruncateInt().Sub(remainPoolBalance.TruncateInt()))),
+	}
+
+	historyRes, err := input.RewardKeeper.DistributionHistory(input.Ctx, &rewardtypes.QueryDistributionHistoryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []rewardtypes.DistributionRecord{expectedRecord}, historyRes.Records)
+
+	var distributionEvent *rewardtypes.EventDistribution
+	for _, event := range input.Ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(&rewardtypes.EventDistribution{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(types.Event(event))
+		require.NoError(t, err)
+		distributionEvent = msg.(*rewardtypes.EventDistribution)
+	}
+	require.NotNil(t, distributionEvent)
+	require.Equal(t, expectedRecord.FeePool, distributionEvent.FeePool)
+	require.Equal(t, expectedRecord.CommunityPool, distributionEvent.CommunityPool)
+	require.Equal(t, expectedRecord.Reserve, distributionEvent.Reserve)
+	require.Equal(t, expectedRecord.FeeCollector, distributionEvent.FeeCollector)
+
+	// only the latest records are kept, also after the limit is lowered
+	for i := int64(1); i <= 3; i++ {
+		record := expectedRecord
+		record.Height = expectedRecord.Height + i
+		require.NoError(t, input.RewardKeeper.RecordDistribution(input.Ctx, record))
+	}
+
+	records, err := input.RewardKeeper.GetAllDistributionRecords(input.Ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, expectedRecord.Height+1, records[0].Height)
+
+	rewardParams.DistributionHistoryLimit = 1
+	require.NoError(t, input.RewardKeeper.SetParams(input.Ctx, rewardParams))
+
+	record := expectedRecord
+	record.Height = expectedRecord.Height + 4
+	require.NoError(t, input.RewardKeeper.RecordDistribution(input.Ctx, record))
+
+	records, err = input.RewardKeeper.GetAllDistributionRecords(input.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, []rewardtypes.DistributionRecord{record}, records)
+}
+
+func TestDripModes(t *testing.T) {
+	input := setupDistribution(t)
+	runBeginBlockers(t, input)
+
+	rewardParams, err := input.RewardKeeper.GetParams(input.Ctx)
+	require.NoError(t, err)
+	uBlockPerYear, err := input.RewardKeeper.GetBlocksPerYear(input.Ctx)
+	require.NoError(t, err)
+	blockPerYear := int64(uBlockPerYear)
+
+	// linear drip mode sends the fixed amount to the fee collector
+	linearDripAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+	rewardParams.DripMode = rewardtypes.DripModeLinear
+	rewardParams.LinearDripAmount = linearDripAmount
+	require.NoError(t, input.RewardKeeper.SetParams(input.Ctx, rewardParams))
+
+	poolBefore := input.RewardKeeper.PoolBalances(input.Ctx)
+	require.NoError(t, reward.BeginBlocker(input.Ctx, input.RewardKeeper, input.BankKeeper, input.StakingKeeper, input.DistrKeeper))
+	require.Equal(t, poolBefore.Sub(linearDripAmount...), input.RewardKeeper.PoolBalances(input.Ctx))
+
+	// target apr drip mode is capped at the reward pool balance
+	rewardParams.DripMode = rewardtypes.DripModeTargetApr
+	rewardParams.TargetApr = sdkmath.LegacyNewDecWithPrec(5, 2)
+	require.NoError(t, input.RewardKeeper.SetParams(input.Ctx, rewardParams))
+
+	bondedTokens, err := input.StakingKeeper.TotalBondedTokens(input.Ctx)
+	require.NoError(t, err)
+	drip, err := input.RewardKeeper.DripAmount(input.Ctx, rewardParams, input.RewardKeeper.PoolBalances(input.Ctx))
+	require.NoError(t, err)
+	expectedDrip := sdkmath.MinInt(
+		rewardParams.TargetApr.MulInt(bondedTokens).QuoInt64(blockPerYear).TruncateInt(),
+		input.RewardKeeper.PoolBalances(input.Ctx).AmountOf(sdk.DefaultBondDenom),
+	)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, expectedDrip)), drip)
+}
+
+func TestEmissionProjection(t *testing.T) {
+	input := setupDistribution(t)
+	runBeginBlockers(t, input)
+
+	rewardParams, err := input.RewardKeeper.GetParams(input.Ctx)
+	require.NoError(t, err)
+	uBlockPerYear, err := input.RewardKeeper.GetBlocksPerYear(input.Ctx)
+	require.NoError(t, err)
+	blockPerYear := int64(uBlockPerYear)
+
+	// exponential drip mode
+	exponentialDrip, err := input.RewardKeeper.DripAmount(input.Ctx, rewardParams, input.RewardKeeper.PoolBalances(input.Ctx))
+	require.NoError(t, err)
+	projectionRes, err := input.RewardKeeper.EmissionProjection(input.Ctx, &rewardtypes.QueryEmissionProjectionRequest{Blocks: 1})
+	require.NoError(t, err)
+	require.Equal(t, exponentialDrip, projectionRes.Amount)
+
+	projectionRes, err = input.RewardKeeper.EmissionProjection(input.Ctx, &rewardtypes.QueryEmissionProjectionRequest{Blocks: uint64(blockPerYear)})
+	require.NoError(t, err)
+	require.True(t, projectionRes.Amount.IsAllLTE(input.RewardKeeper.PoolBalances(input.Ctx)))
+
+	_, err = input.RewardKeeper.EmissionProjection(input.Ctx, &rewardtypes.QueryEmissionProjectionRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the projection is bounded to a year of blocks
+	_, err = input.RewardKeeper.EmissionProjection(input.Ctx, &rewardtypes.QueryEmissionProjectionRequest{Blocks: uint64(blockPerYear) + 1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// target apr drip mode
+	rewardParams.DripMode = rewardtypes.DripModeTargetApr
+	rewardParams.TargetApr = sdkmath.LegacyNewDecWithPrec(5, 2)
+	require.NoError(t, input.RewardKeeper.SetParams(input.Ctx, rewardParams))
+
+	drip, err := input.RewardKeeper.DripAmount(input.Ctx, rewardParams, input.RewardKeeper.PoolBalances(input.Ctx))
+	require.NoError(t, err)
+
+	projectionRes, err = input.RewardKeeper.EmissionProjection(input.Ctx, &rewardtypes.QueryEmissionProjectionRequest{Blocks: 10})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, drip.AmountOf(sdk.DefaultBondDenom).MulRaw(10))), projectionRes.Amount)
+	require.True(t, projectionRes.Apr.LTE(rewardParams.TargetApr))
+	require.True(t, rewardParams.TargetApr.Sub(projectionRes.Apr).LT(sdkmath.LegacyNewDecWithPrec(1, 9)))
+}
+
+// TestPendingReserve checks that failed reserve transfers are escrowed in the
+// pending reserve and retried
+func TestPendingReserve(t *testing.T) {
+	input := fixture.Branch(t)
+
+	tempAccount := sdk.AccAddress(testutil.Pks[testutil.TempIndex].Address())
+	reserveAccount := sdk.AccAddress(testutil.Pks[testutil.ReserveIndex].Address())
+	reserveAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+	escrowAccount := authtypes.NewModuleAddress(rewardtypes.ReserveEscrowName)
+
+	restricted := true
+	input.BankKeeper.AppendSendRestriction(func(_ context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if restricted && toAddr.Equals(reserveAccount) {
+			return nil, fmt.Errorf("restricted reserve account")
+		}
+		return toAddr, nil
+	})
+	defer func() { restricted = false }()
+
+	tempBalance := input.BankKeeper.GetBalance(input.Ctx, tempAccount, sdk.DefaultBondDenom)
+	require.NoError(t, input.InitAccountWithCoins(tempAccount, reserveAmount))
+
+	sent, err := input.RewardKeeper.SendReserve(input.Ctx, tempAccount, reserveAccount, reserveAmount)
+	require.NoError(t, err)
+	require.True(t, sent.IsZero())
+	require.True(t, hasEvent(input.Ctx, &rewardtypes.EventReserveTransferFailed{}))
+	require.Equal(t, tempBalance, input.BankKeeper.GetBalance(input.Ctx, tempAccount, sdk.DefaultBondDenom))
+	require.Equal(t, reserveAmount, input.BankKeeper.GetAllBalances(input.Ctx, escrowAccount))
+
+	pendingRes, err := input.RewardKeeper.PendingReserve(input.Ctx, &rewardtypes.QueryPendingReserveRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []rewardtypes.PendingReserve{{
+		Account:        tempAccount.String(),
+		ReserveAccount: reserveAccount.String(),
+		Amount:         reserveAmount,
+	}}, pendingRes.PendingReserves)
+
+	// a failed retry backs off before the next one
+	retried, err := input.RewardKeeper.RetryPendingReserves(input.Ctx)
+	require.NoError(t, err)
+	require.True(t, retried.IsZero())
+	require.True(t, hasEvent(input.Ctx, &rewardtypes.EventReserveRetryFailed{}))
+	require.Equal(t, reserveAmount, input.BankKeeper.GetAllBalances(input.Ctx, escrowAccount))
+
+	pendingReserve, err := input.RewardKeeper.GetPendingReserve(input.Ctx, tempAccount, reserveAccount)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), pendingReserve.Retries)
+	require.Equal(t, input.Ctx.BlockHeight()+2, pendingReserve.NextRetryHeight)
+
+	restricted = false
+	retried, err = input.RewardKeeper.RetryPendingReserves(input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1))
+	require.NoError(t, err)
+	require.True(t, retried.IsZero())
+	require.Equal(t, reserveAmount, input.BankKeeper.GetAllBalances(input.Ctx, escrowAccount))
+
+	// the retry is due and runs even without distribution sources
+	rewardParams, err := input.RewardKeeper.GetParams(input.Ctx)
+	require.NoError(t, err)
+	rewardParams.DistributionSources = nil
+	require.NoError(t, input.RewardKeeper.SetParams(input.Ctx, rewardParams))
+
+	reserveBalance := input.BankKeeper.GetBalance(input.Ctx, reserveAccount, sdk.DefaultBondDenom)
+	require.NoError(t, reward.BeginBlocker(input.Ctx.WithBlockHeight(input.Ctx.BlockHeight()+2), input.RewardKeeper, input.BankKeeper, input.StakingKeeper, input.DistrKeeper))
+	require.Equal(t, reserveBalance.Add(reserveAmount[0]), input.BankKeeper.GetBalance(input.Ctx, reserveAccount, sdk.DefaultBondDenom))
+	require.True(t, input.BankKeeper.GetAllBalances(input.Ctx, escrowAccount).IsZero())
+
+	pendingReserves, err := input.RewardKeeper.GetAllPendingReserves(input.Ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingReserves)
+
+	// the retry uses the send path of the original transfer, so a blocked
+	// reserve account can still receive it
+	blockedReserveAccount := input.AccountKeeper.GetModuleAccount(input.Ctx, distrtypes.ModuleName).GetAddress()
+	require.True(t, input.BankKeeper.BlockedAddr(blockedReserveAccount))
+
+	restricted = true
+	reserveAccount = blockedReserveAccount
+	require.NoError(t, input.InitAccountWithCoins(tempAccount, reserveAmount))
+	_, err = input.RewardKeeper.SendReserve(input.Ctx, tempAccount, blockedReserveAccount, reserveAmount)
+	require.NoError(t, err)
+
+	restricted = false
+	retried, err = input.RewardKeeper.RetryPendingReserves(input.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, reserveAmount, retried)
+
+	// rewards which cannot be escrowed stay in the distribution source
+	// account without failing the block
+	restricted = true
+	emptyAccount := sdk.AccAddress([]byte("empty_source_account"))
+	sent, err = input.RewardKeeper.SendReserve(input.Ctx, emptyAccount, blockedReserveAccount, reserveAmount)
+	require.NoError(t, err)
+	require.True(t, sent.IsZero())
+
+	pendingReserves, err = input.RewardKeeper.GetAllPendingReserves(input.Ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingReserves)
+
+	// the governance resolves a pending reserve to an account or the
+	// community pool
+	msgServer := keeper.NewMsgServerImpl(input.RewardKeeper)
+	authority := input.RewardKeeper.GetAuthority()
+
+	require.NoError(t, input.InitAccountWithCoins(tempAccount, reserveAmount.MulInt(sdkmath.NewInt(2))))
+	_, err = input.RewardKeeper.SendReserve(input.Ctx, tempAccount, blockedReserveAccount, reserveAmount)
+	require.NoError(t, err)
+
+	_, err = msgServer.ResolvePendingReserve(input.Ctx, rewardtypes.NewMsgResolvePendingReserve(tempAccount.String(), tempAccount.String(), blockedReserveAccount.String(), ""))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	tempBalance = input.BankKeeper.GetBalance(input.Ctx, tempAccount, sdk.DefaultBondDenom)
+	_, err = msgServer.ResolvePendingReserve(input.Ctx, rewardtypes.NewMsgResolvePendingReserve(authority, tempAccount.String(), blockedReserveAccount.String(), tempAccount.String()))
+	require.NoError(t, err)
+	require.True(t, hasEvent(input.Ctx, &rewardtypes.EventPendingReserveResolved{}))
+	require.Equal(t, tempBalance.Add(reserveAmount[0]), input.BankKeeper.GetBalance(input.Ctx, tempAccount, sdk.DefaultBondDenom))
+
+	_, err = msgServer.ResolvePendingReserve(input.Ctx, rewardtypes.NewMsgResolvePendingReserve(authority, tempAccount.String(), blockedReserveAccount.String(), tempAccount.String()))
+	require.ErrorIs(t, err, rewardtypes.ErrPendingReserveNotFound)
+
+	_, err = input.RewardKeeper.SendReserve(input.Ctx, tempAccount, blockedReserveAccount, reserveAmount)
+	require.NoError(t, err)
+	restricted = false
+
+	feePool, err := input.DistrKeeper.FeePool.Get(input.Ctx)
+	require.NoError(t, err)
+	_, err = msgServer.ResolvePendingReserve(input.Ctx, rewardtypes.NewMsgResolvePendingReserve(authority, tempAccount.String(), blockedReserveAccount.String(), ""))
+	require.NoError(t, err)
+
+	resolvedFeePool, err := input.DistrKeeper.FeePool.Get(input.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(reserveAmount...)...), resolvedFeePool.CommunityPool)
+	require.True(t, input.BankKeeper.GetAllBalances(input.Ctx, escrowAccount).IsZero())
+}
+
+func hasEvent(ctx sdk.Context, event proto.Message) bool {
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == proto.MessageName(event) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package reward_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/xpladev/xpla/tests/integration/testutil"
+	"github.com/xpladev/xpla/x/reward/keeper"
+	rewardtypes "github.com/xpladev/xpla/x/reward/types"
+)
+
+func TestWithdrawRewardPool(t *testing.T) {
+	input := fixture.Branch(t)
+
+	msgServer := keeper.NewMsgServerImpl(input.RewardKeeper)
+
+	depositor := sdk.AccAddress(testutil.Pks[testutil.TempIndex].Address())
+	recipient := sdk.AccAddress(testutil.Pks[testutil.ReserveIndex].Address())
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+
+	require.NoError(t, input.InitAccountWithCoins(depositor, funds))
+	require.NoError(t, input.RewardKeeper.FundRewardPool(input.Ctx, funds, depositor))
+
+	pool := input.RewardKeeper.PoolBalances(input.Ctx)
+	recipientBalances := input.BankKeeper.GetAllBalances(input.Ctx, recipient)
+
+	authority := input.RewardKeeper.GetAuthority()
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(300)))
+
+	// invalid authority
+	_, err := msgServer.WithdrawRewardPool(input.Ctx, rewardtypes.NewMsgWithdrawRewardPool(depositor.String(), amount, recipient.String()))
+	require.Error(t, err)
+
+	// withdraw to the recipient
+	_, err = msgServer.WithdrawRewardPool(input.Ctx, rewardtypes.NewMsgWithdrawRewardPool(authority, amount, recipient.String()))
+	require.NoError(t, err)
+	require.Equal(t, recipientBalances.Add(amount...), input.BankKeeper.GetAllBalances(input.Ctx, recipient))
+	require.Equal(t, pool.Sub(amount...), input.RewardKeeper.PoolBalances(input.Ctx))
+
+	// withdraw to the community pool
+	feePool, err := input.DistrKeeper.FeePool.Get(input.Ctx)
+	require.NoError(t, err)
+	communityPool := feePool.CommunityPool
+
+	_, err = msgServer.WithdrawRewardPool(input.Ctx, rewardtypes.NewMsgWithdrawRewardPool(authority, amount, ""))
+	require.NoError(t, err)
+	require.Equal(t, pool.Sub(amount...).Sub(amount...), input.RewardKeeper.PoolBalances(input.Ctx))
+
+	feePool, err = input.DistrKeeper.FeePool.Get(input.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, communityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), feePool.CommunityPool)
+
+	// exceeding the reward pool
+	_, err = msgServer.WithdrawRewardPool(input.Ctx, rewardtypes.NewMsgWithdrawRewardPool(authority, pool, recipient.String()))
+	require.Error(t, err)
+}
+
+func TestFundableDenoms(t *testing.T) {
+	input := fixture.Branch(t)
+
+	depositor := sdk.AccAddress(testutil.Pks[testutil.TempIndex].Address())
+	dust := sdk.NewCoins(sdk.NewCoin("dust", sdkmath.NewInt(100)))
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+
+	require.NoError(t, input.InitAccountWithCoins(depositor, funds.Add(dust...)))
+
+	// every denom is fundable without the fundable denoms
+	require.NoError(t, input.RewardKeeper.FundRewardPool(input.Ctx, dust, depositor))
+
+	params, err := input.RewardKeeper.GetParams(input.Ctx)
+	require.NoError(t, err)
+	params.FundableDenoms = []string{sdk.DefaultBondDenom}
+	require.NoError(t, input.RewardKeeper.SetParams(input.Ctx, params))
+
+	err = input.RewardKeeper.FundRewardPool(input.Ctx, dust, depositor)
+	require.ErrorIs(t, err, rewardtypes.ErrDenomNotFundable)
+	require.NoError(t, input.RewardKeeper.FundRewardPool(input.Ctx, funds, depositor))
+
+	res, err := input.RewardKeeper.Pool(input.Ctx, &rewardtypes.QueryPoolRequest{})
+	require.NoError(t, err)
+	require.Equal(t, dust, res.IneligiblePool)
+	require.Equal(t, res.Pool, res.EligiblePool.Add(res.IneligiblePool...))
+	require.Equal(t, res.EligiblePool, input.RewardKeeper.EligiblePoolBalances(input.Ctx, params))
+
+	// only the fundable denoms are dripped
+	drip, err := input.RewardKeeper.DripAmount(input.Ctx, params, input.RewardKeeper.EligiblePoolBalances(input.Ctx, params))
+	require.NoError(t, err)
+	require.True(t, drip.AmountOf("dust").IsZero())
+
+	params.FundableDenoms = []string{}
+	require.NoError(t, input.RewardKeeper.SetParams(input.Ctx, params))
+}
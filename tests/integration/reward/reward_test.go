@@ -0,0 +1,18 @@
+package reward_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/xpladev/xpla/tests/integration/testutil"
+)
+
+// the wasm VM can only be created once per process, so the tests of this
+// package branch from a single test input
+var fixture testutil.TestInput
+
+func TestMain(m *testing.M) {
+	fixture = testutil.NewTestInput()
+
+	os.Exit(m.Run())
+}
@@ -1,10 +1,15 @@
 package testutil
 
 import (
+	"sync"
 	"testing"
 	"time"
 
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	vmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	vmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
@@ -15,8 +20,11 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -26,10 +34,13 @@ import (
 	xplaApp "github.com/xpladev/xpla/app"
 	authkeeper "github.com/xpladev/xpla/x/auth/keeper"
 	bankkeeper "github.com/xpladev/xpla/x/bank/keeper"
+	burnkeeper "github.com/xpladev/xpla/x/burn/keeper"
+	burntypes "github.com/xpladev/xpla/x/burn/types"
 	rewardkeeper "github.com/xpladev/xpla/x/reward/keeper"
 	rewardtypes "github.com/xpladev/xpla/x/reward/types"
 	stakingkeeper "github.com/xpladev/xpla/x/staking/keeper"
 	volunteerkeeper "github.com/xpladev/xpla/x/volunteer/keeper"
+	volunteertypes "github.com/xpladev/xpla/x/volunteer/types"
 )
 
 const (
@@ -44,6 +55,9 @@ const (
 
 var (
 	Pks = simtestutil.CreateTestPubKeys(TotalCount)
+
+	// the evm configuration is global and can only be set once per process
+	evmConfigOnce sync.Once
 )
 
 // TestInput nolint
@@ -57,12 +71,26 @@ type TestInput struct {
 	SlashingKeeper  slashingkeeper.Keeper
 	DistrKeeper     distrkeeper.Keeper
 	VolunteerKeeper volunteerkeeper.Keeper
+	BurnKeeper      burnkeeper.Keeper
+	GovKeeper       *govkeeper.Keeper
+	AuthzKeeper     authzkeeper.Keeper
+	EvmKeeper       *vmkeeper.Keeper
+	WasmKeeper      wasmkeeper.Keeper
 
 	StakingHandler *stakingtestutil.Helper
 }
 
 // CreateTestInput nolint
 func CreateTestInput(t *testing.T) TestInput {
+	input := NewTestInput()
+	input.StakingHandler = stakingtestutil.NewHelper(t, input.Ctx, input.StakingKeeper.Keeper)
+
+	return input
+}
+
+// NewTestInput creates the test input without a staking helper, so that it
+// can be shared by the tests of a package through TestMain.
+func NewTestInput() TestInput {
 	app := xplaApp.NewXplaApp(
 		log.NewNopLogger(),
 		dbm.NewMemDB(),
@@ -92,16 +120,48 @@ func CreateTestInput(t *testing.T) TestInput {
 
 	keepers.MintKeeper.Params.Set(ctx, minttypes.DefaultParams())
 
-	rewardParams := rewardtypes.Params{
-		FeePoolRate:             sdkmath.LegacyNewDecWithPrec(20, 2),
-		CommunityPoolRate:       sdkmath.LegacyNewDecWithPrec(79, 2),
-		ReserveRate:             sdkmath.LegacyNewDecWithPrec(1, 2),
-		ReserveAccount:          sdk.AccAddress(Pks[ReserveIndex].Address()).String(),
-		RewardDistributeAccount: sdk.AccAddress(Pks[ValidatorSettlementIndex].Address()).String(),
+	rewardParams := rewardtypes.DefaultParams()
+	rewardParams.DistributionSources = []rewardtypes.DistributionSource{
+		rewardtypes.NewDistributionSource(
+			sdk.AccAddress(Pks[ValidatorSettlementIndex].Address()).String(),
+			sdkmath.LegacyNewDecWithPrec(20, 2),
+			sdkmath.LegacyNewDecWithPrec(79, 2),
+			sdkmath.LegacyNewDecWithPrec(1, 2),
+			sdk.AccAddress(Pks[ReserveIndex].Address()).String(),
+		),
 	}
 	keepers.RewardKeeper.SetParams(ctx, rewardParams)
 
-	sh := stakingtestutil.NewHelper(t, ctx, app.AppKeepers.StakingKeeper.Keeper)
+	keepers.BurnKeeper.SetParams(ctx, burntypes.DefaultParams())
+
+	keepers.VolunteerKeeper.SetParams(ctx, volunteertypes.DefaultParams())
+
+	keepers.GovKeeper.Params.Set(ctx, govv1types.DefaultParams())
+	keepers.GovKeeper.ProposalID.Set(ctx, govv1types.DefaultStartingProposalID)
+
+	keepers.WasmKeeper.SetParams(ctx, wasmtypes.DefaultParams())
+
+	evmParams := vmtypes.DefaultParams()
+	evmParams.EvmDenom = sdk.DefaultBondDenom
+	keepers.EvmKeeper.SetParams(ctx, evmParams)
+
+	coinInfo := vmtypes.EvmCoinInfo{
+		Denom:         sdk.DefaultBondDenom,
+		ExtendedDenom: sdk.DefaultBondDenom,
+		DisplayDenom:  sdk.DefaultBondDenom,
+		Decimals:      vmtypes.EighteenDecimals.Uint32(),
+	}
+	keepers.EvmKeeper.SetEvmCoinInfo(ctx, coinInfo)
+	evmConfigOnce.Do(func() {
+		err := vmtypes.NewEVMConfigurator().
+			WithExtendedEips(vmtypes.DefaultCosmosEVMActivators).
+			WithEVMCoinInfo(coinInfo).
+			Configure()
+		if err != nil {
+			panic(err)
+		}
+	})
+
 	app.ModuleBasics.RegisterInterfaces(app.InterfaceRegistry())
 
 	return TestInput{
@@ -114,10 +174,24 @@ func CreateTestInput(t *testing.T) TestInput {
 		app.AppKeepers.SlashingKeeper,
 		app.AppKeepers.DistrKeeper,
 		app.AppKeepers.VolunteerKeeper,
-		sh,
+		app.AppKeepers.BurnKeeper,
+		app.AppKeepers.GovKeeper,
+		app.AppKeepers.AuthzKeeper,
+		app.AppKeepers.EvmKeeper,
+		app.AppKeepers.WasmKeeper,
+		nil,
 	}
 }
 
+// Branch returns a copy of the test input running on a cache context of its
+// context, so that the state changes of a test are not shared with others.
+func (ti TestInput) Branch(t *testing.T) TestInput {
+	ti.Ctx, _ = ti.Ctx.CacheContext()
+	ti.StakingHandler = stakingtestutil.NewHelper(t, ti.Ctx, ti.StakingKeeper.Keeper)
+
+	return ti
+}
+
 func (ti *TestInput) InitAccountWithCoins(addr sdk.AccAddress, coins sdk.Coins) error {
 	err := ti.BankKeeper.MintCoins(ti.Ctx, minttypes.ModuleName, coins)
 	if err != nil {
@@ -0,0 +1,107 @@
+package testutil
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmcontracts "github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+
+	banktypes "github.com/xpladev/xpla/x/bank/types"
+)
+
+// miscDir returns the directory of the contracts of the e2e tests.
+func miscDir() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "e2e", "misc")
+}
+
+// WithValidatorProposer makes a new validator the block proposer, which the
+// evm requires to run.
+func (ti *TestInput) WithValidatorProposer(t *testing.T, ctx sdk.Context) sdk.Context {
+	proposer := sdk.ValAddress(Pks[3].Address())
+	bondAmount := ti.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	require.NoError(t, ti.InitAccountWithCoins(sdk.AccAddress(proposer), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bondAmount))))
+	stakingtestutil.NewHelper(t, ctx, ti.StakingKeeper.Keeper).CreateValidator(proposer, Pks[3], bondAmount, true)
+
+	return ctx.WithProposer(sdk.ConsAddress(Pks[3].Address()))
+}
+
+// DeployErc20 deploys the erc20 token of the e2e tests, which mints 100 tokens
+// to the deployer and does not implement burn, and returns its denom.
+func (ti *TestInput) DeployErc20(t *testing.T, ctx sdk.Context, deployer sdk.AccAddress) string {
+	bin, err := os.ReadFile(filepath.Join(miscDir(), "token.sol.bin"))
+	require.NoError(t, err)
+	abiJSON, err := os.ReadFile(filepath.Join(miscDir(), "token.sol.abi"))
+	require.NoError(t, err)
+
+	tokenABI, err := abi.JSON(bytes.NewReader(abiJSON))
+	require.NoError(t, err)
+	args, err := tokenABI.Pack("", "erc20 token", "ERC")
+	require.NoError(t, err)
+
+	return ti.deployContract(t, ctx, deployer, append(common.FromHex(string(bin)), args...))
+}
+
+// DeployBurnableErc20 deploys an erc20 token which implements burn, mints the
+// amount to the deployer and returns its denom.
+func (ti *TestInput) DeployBurnableErc20(t *testing.T, ctx sdk.Context, deployer sdk.AccAddress, amount sdkmath.Int) string {
+	contract := evmcontracts.ERC20MinterBurnerDecimalsContract
+	args, err := contract.ABI.Pack("", "burnable token", "BRN", uint8(18))
+	require.NoError(t, err)
+
+	denom := ti.deployContract(t, ctx, deployer, append(contract.Bin, args...))
+	_, contractAddress := banktypes.ParseDenom(denom)
+
+	from := common.BytesToAddress(deployer)
+	stateDB := statedb.New(ctx, ti.EvmKeeper, statedb.NewEmptyTxConfig())
+	_, err = ti.EvmKeeper.CallEVM(ctx, stateDB, contract.ABI, from, common.HexToAddress(contractAddress), true, false, nil, "mint", from, amount.BigInt())
+	require.NoError(t, err)
+
+	return denom
+}
+
+func (ti *TestInput) deployContract(t *testing.T, ctx sdk.Context, deployer sdk.AccAddress, data []byte) string {
+	from := common.BytesToAddress(deployer)
+	nonce, err := ti.AccountKeeper.GetSequence(ctx, deployer)
+	require.NoError(t, err)
+
+	stateDB := statedb.New(ctx, ti.EvmKeeper, statedb.NewEmptyTxConfig())
+	_, err = ti.EvmKeeper.CallEVMWithData(ctx, stateDB, from, nil, data, true, false, nil)
+	require.NoError(t, err)
+
+	return banktypes.NewErc20Coin(crypto.CreateAddress(from, nonce).Hex(), sdkmath.ZeroInt()).Denom
+}
+
+// InstantiateCw20 instantiates the cw20 token of the e2e tests with the
+// initial balance of the owner and returns its denom.
+func (ti *TestInput) InstantiateCw20(t *testing.T, ctx sdk.Context, owner sdk.AccAddress, amount sdkmath.Int) string {
+	code, err := os.ReadFile(filepath.Join(miscDir(), "token.wasm"))
+	require.NoError(t, err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(ti.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(ctx, owner, code, nil)
+	require.NoError(t, err)
+
+	initMsg := fmt.Sprintf(`{"name":"cw20 token","symbol":"CWT","decimals":6,"initial_balances":[{"address":"%s","amount":"%s"}]}`, owner, amount)
+	contractAddress, _, err := contractKeeper.Instantiate(ctx, codeID, owner, owner, []byte(initMsg), "cw20", nil)
+	require.NoError(t, err)
+
+	return banktypes.NewCw20Coin(contractAddress.String(), sdkmath.ZeroInt()).Denom
+}
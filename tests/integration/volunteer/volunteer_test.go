@@ -0,0 +1,491 @@
+package volunteer_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	sdkstakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/xpladev/xpla/tests/integration/testutil"
+	"github.com/xpladev/xpla/x/staking"
+	"github.com/xpladev/xpla/x/volunteer"
+	volunteerkeeper "github.com/xpladev/xpla/x/volunteer/keeper"
+	"github.com/xpladev/xpla/x/volunteer/types"
+)
+
+// the wasm VM can only be created once per process, so the tests of this
+// package branch from a single test input
+var fixture testutil.TestInput
+
+func TestMain(m *testing.M) {
+	fixture = testutil.NewTestInput()
+
+	os.Exit(m.Run())
+}
+
+func newMsgRegisterVolunteerValidator(t *testing.T, input *testutil.TestInput, index int, power int64) *types.MsgRegisterVolunteerValidator {
+	valAddress := sdk.ValAddress(testutil.Pks[index].Address())
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.DefaultPowerReduction.MulRaw(power))
+	require.NoError(t, input.InitAccountWithCoins(sdk.AccAddress(valAddress), sdk.NewCoins(amount)))
+
+	pubkey, err := codectypes.NewAnyWithValue(testutil.Pks[index])
+	require.NoError(t, err)
+
+	msg := &types.MsgRegisterVolunteerValidator{
+		Authority:            input.VolunteerKeeper.GetAuthority(),
+		ValidatorDescription: stakingtypes.NewDescription("volunteer", "", "", "", ""),
+		DelegatorAddress:     sdk.AccAddress(valAddress).String(),
+		ValidatorAddress:     valAddress.String(),
+		Pubkey:               pubkey,
+		Amount:               amount,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	return msg
+}
+
+func registerVolunteerValidator(t *testing.T, input *testutil.TestInput, index int) sdk.ValAddress {
+	msg := newMsgRegisterVolunteerValidator(t, input, index, 1)
+	_, err := volunteerkeeper.NewMsgServerImpl(input.VolunteerKeeper).RegisterVolunteerValidator(input.Ctx, msg)
+	require.NoError(t, err)
+
+	_, err = staking.EndBlocker(input.Ctx, input.StakingKeeper)
+	require.NoError(t, err)
+
+	return sdk.ValAddress(testutil.Pks[index].Address())
+}
+
+func TestTermLimit(t *testing.T) {
+	input := fixture.Branch(t)
+	querier := volunteerkeeper.Querier{Keeper: input.VolunteerKeeper}
+
+	params := types.DefaultParams()
+	params.MaxTermBlocks = 10
+	_, err := volunteerkeeper.NewMsgServerImpl(input.VolunteerKeeper).UpdateParams(input.Ctx, &types.MsgUpdateParams{
+		Authority: input.VolunteerKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	valAddress := registerVolunteerValidator(t, &input, 0)
+
+	input.Ctx = input.Ctx.WithBlockHeight(5)
+	res, err := querier.VolunteerValidatorTerm(input.Ctx, &types.QueryVolunteerValidatorTermRequest{ValidatorAddress: valAddress.String()})
+	require.NoError(t, err)
+	require.Equal(t, int64(11), res.TermEndHeight)
+	require.Equal(t, int64(6), res.RemainingBlocks)
+
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	require.NoError(t, volunteer.EndBlocker(input.Ctx, input.VolunteerKeeper))
+	_, err = input.VolunteerKeeper.GetVolunteerValidator(input.Ctx, valAddress)
+	require.NoError(t, err)
+
+	input.Ctx = input.Ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, volunteer.EndBlocker(input.Ctx, input.VolunteerKeeper))
+	_, err = input.VolunteerKeeper.GetVolunteerValidator(input.Ctx, valAddress)
+	require.Error(t, err)
+
+	_, err = input.StakingKeeper.GetDelegation(input.Ctx, sdk.AccAddress(valAddress), valAddress)
+	require.ErrorIs(t, err, stakingtypes.ErrNoDelegation)
+
+	countTermEnded := func() int {
+		count := 0
+		for _, event := range input.Ctx.EventManager().Events() {
+			if event.Type == proto.MessageName(&types.EventVolunteerValidatorTermEnded{}) {
+				count++
+			}
+		}
+		return count
+	}
+	require.Equal(t, 1, countTermEnded())
+
+	// the record of an ended term is removed even without the staking validator
+	staleAddress := sdk.ValAddress(testutil.Pks[8].Address())
+	staleValidator := types.NewVolunteerValidator(staleAddress, 0)
+	staleValidator.TermEndHeight = 11
+	require.NoError(t, input.VolunteerKeeper.SetVolunteerValidator(input.Ctx, staleAddress, staleValidator))
+
+	input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, volunteer.EndBlocker(input.Ctx, input.VolunteerKeeper))
+	_, err = input.VolunteerKeeper.GetVolunteerValidator(input.Ctx, staleAddress)
+	require.Error(t, err)
+	require.Equal(t, 1, countTermEnded())
+
+	input.Ctx = input.Ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, volunteer.EndBlocker(input.Ctx, input.VolunteerKeeper))
+	require.Equal(t, 0, countTermEnded())
+}
+
+func TestTermEndRetry(t *testing.T) {
+	input := fixture.Branch(t)
+
+	params := types.DefaultParams()
+	params.MaxTermBlocks = 10
+	require.NoError(t, input.VolunteerKeeper.SetParams(input.Ctx, params))
+
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	valAddress := registerVolunteerValidator(t, &input, 4)
+
+	// the self undelegation fails while the unbonding entries are full
+	maxEntries, err := input.StakingKeeper.MaxEntries(input.Ctx)
+	require.NoError(t, err)
+	for i := range maxEntries {
+		_, err := input.StakingKeeper.SetUnbondingDelegationEntry(input.Ctx, sdk.AccAddress(valAddress), valAddress, int64(i+1), input.Ctx.BlockTime(), sdkmath.OneInt())
+		require.NoError(t, err)
+	}
+
+	input.Ctx = input.Ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, volunteer.EndBlocker(input.Ctx, input.VolunteerKeeper))
+	_, err = input.VolunteerKeeper.GetVolunteerValidator(input.Ctx, valAddress)
+	require.NoError(t, err)
+	for _, event := range input.Ctx.EventManager().Events() {
+		require.NotEqual(t, proto.MessageName(&types.EventVolunteerValidatorTermEnded{}), event.Type)
+	}
+
+	// the unregistration is retried in the next block
+	ubd, err := input.StakingKeeper.GetUnbondingDelegation(input.Ctx, sdk.AccAddress(valAddress), valAddress)
+	require.NoError(t, err)
+	require.NoError(t, input.StakingKeeper.RemoveUnbondingDelegation(input.Ctx, ubd))
+
+	input.Ctx = input.Ctx.WithBlockHeight(12)
+	require.NoError(t, volunteer.EndBlocker(input.Ctx, input.VolunteerKeeper))
+	_, err = input.VolunteerKeeper.GetVolunteerValidator(input.Ctx, valAddress)
+	require.Error(t, err)
+
+	_, err = input.StakingKeeper.GetDelegation(input.Ctx, sdk.AccAddress(valAddress), valAddress)
+	require.ErrorIs(t, err, stakingtypes.ErrNoDelegation)
+}
+
+func TestVolunteerLimits(t *testing.T) {
+	input := fixture.Branch(t)
+	msgServer := volunteerkeeper.NewMsgServerImpl(input.VolunteerKeeper)
+
+	params := types.DefaultParams()
+	params.MaxVolunteerValidators = 1
+	params.MaxVolunteerPowerFraction = sdkmath.LegacyNewDecWithPrec(2, 1)
+	require.NoError(t, input.VolunteerKeeper.SetParams(input.Ctx, params))
+
+	// 1 general validator with 100 power
+	general := sdk.ValAddress(testutil.Pks[1].Address())
+	require.NoError(t, input.InitAccountWithCoins(sdk.AccAddress(general), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.DefaultPowerReduction.MulRaw(100)))))
+	_, err := input.StakingHandler.CreateValidatorWithMsg(input.Ctx, testutil.NewMsgCreateValidator(general, testutil.Pks[1], sdk.DefaultPowerReduction.MulRaw(100)))
+	require.NoError(t, err)
+
+	// 1 volunteer validator with 100 power
+	_, err = msgServer.RegisterVolunteerValidator(input.Ctx, newMsgRegisterVolunteerValidator(t, &input, 2, 100))
+	require.NoError(t, err)
+	volunteerAddress := sdk.ValAddress(testutil.Pks[2].Address())
+
+	// the number of the volunteer validators is capped
+	_, err = msgServer.RegisterVolunteerValidator(input.Ctx, newMsgRegisterVolunteerValidator(t, &input, 3, 1))
+	require.ErrorIs(t, err, types.ErrMaxVolunteerValidators)
+
+	_, err = staking.EndBlocker(input.Ctx, input.StakingKeeper)
+	require.NoError(t, err)
+
+	// the volunteer power is clamped to 0.2 / (1 - 0.2) * 100
+	volunteerPower, err := input.StakingKeeper.GetLastValidatorPower(input.Ctx, volunteerAddress)
+	require.NoError(t, err)
+	require.Equal(t, int64(25), volunteerPower)
+
+	generalPower, err := input.StakingKeeper.GetLastValidatorPower(input.Ctx, general)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), generalPower)
+
+	totalPower, err := input.StakingKeeper.GetLastTotalPower(input.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(125), totalPower)
+
+	lastValidators, err := input.StakingKeeper.GetLastValidators(input.Ctx)
+	require.NoError(t, err)
+	require.Len(t, lastValidators, 2)
+
+	// the volunteer validator is slashed by its tokens, not by its clamped power
+	slashCtx, _ := input.Ctx.CacheContext()
+	validator, err := input.StakingKeeper.GetValidator(slashCtx, volunteerAddress)
+	require.NoError(t, err)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	fraction := sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, input.SlashingKeeper.Slash(slashCtx, consAddr, fraction, volunteerPower, slashCtx.BlockHeight()))
+
+	slashed, err := input.StakingKeeper.GetValidator(slashCtx, volunteerAddress)
+	require.NoError(t, err)
+	require.Equal(t, validator.Tokens.ToLegacyDec().Mul(fraction).TruncateInt(), validator.Tokens.Sub(slashed.Tokens))
+
+	// the slash uses the tokens at the infraction height even if the clamp
+	// changes before the slash
+	slashInput := input.Branch(t)
+	infractionHeight := slashInput.Ctx.BlockHeight()
+	require.NoError(t, slashInput.StakingKeeper.TrackHistoricalInfo(slashInput.Ctx))
+
+	delegation := sdk.NewCoin(sdk.DefaultBondDenom, sdk.DefaultPowerReduction.MulRaw(200))
+	require.NoError(t, slashInput.InitAccountWithCoins(sdk.AccAddress(general), sdk.NewCoins(delegation)))
+	_, err = sdkstakingkeeper.NewMsgServerImpl(slashInput.StakingKeeper.Keeper).Delegate(slashInput.Ctx, stakingtypes.NewMsgDelegate(sdk.AccAddress(general).String(), general.String(), delegation))
+	require.NoError(t, err)
+
+	slashInput.Ctx = slashInput.Ctx.WithBlockHeight(infractionHeight + 1)
+	_, err = staking.EndBlocker(slashInput.Ctx, slashInput.StakingKeeper)
+	require.NoError(t, err)
+
+	// the volunteer power is clamped to 0.2 / (1 - 0.2) * 300
+	clampedPower, err := slashInput.StakingKeeper.GetLastValidatorPower(slashInput.Ctx, volunteerAddress)
+	require.NoError(t, err)
+	require.Equal(t, int64(75), clampedPower)
+
+	require.NoError(t, slashInput.SlashingKeeper.Slash(slashInput.Ctx, consAddr, fraction, volunteerPower, infractionHeight))
+
+	slashed, err = slashInput.StakingKeeper.GetValidator(slashInput.Ctx, volunteerAddress)
+	require.NoError(t, err)
+	require.Equal(t, validator.Tokens.ToLegacyDec().Mul(fraction).TruncateInt(), validator.Tokens.Sub(slashed.Tokens))
+}
+
+func TestDelegationRestriction(t *testing.T) {
+	input := fixture.Branch(t)
+
+	general := sdk.ValAddress(testutil.Pks[1].Address())
+	require.NoError(t, input.InitAccountWithCoins(sdk.AccAddress(general), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.DefaultPowerReduction))))
+	_, err := input.StakingHandler.CreateValidatorWithMsg(input.Ctx, testutil.NewMsgCreateValidator(general, testutil.Pks[1], sdk.DefaultPowerReduction))
+	require.NoError(t, err)
+	volunteerAddress := registerVolunteerValidator(t, &input, 2)
+
+	delegator := sdk.AccAddress(testutil.Pks[5].Address())
+	grantee := sdk.AccAddress(testutil.Pks[6].Address())
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.DefaultPowerReduction)
+	require.NoError(t, input.InitAccountWithCoins(delegator, sdk.NewCoins(amount.Add(amount))))
+	require.NoError(t, input.InitAccountWithCoins(sdk.AccAddress(volunteerAddress), sdk.NewCoins(amount)))
+
+	stakingKeeper := *input.StakingKeeper.Keeper
+	stakingMsgServer := sdkstakingkeeper.NewMsgServerImpl(&stakingKeeper)
+	msgDelegate := stakingtypes.NewMsgDelegate(delegator.String(), volunteerAddress.String(), amount)
+
+	t.Run("msg server", func(t *testing.T) {
+		_, err := stakingMsgServer.Delegate(input.Ctx, msgDelegate)
+		require.ErrorIs(t, err, types.ErrVolunteerValidatorDelegation)
+
+		_, err = stakingMsgServer.Delegate(input.Ctx, stakingtypes.NewMsgDelegate(delegator.String(), general.String(), amount))
+		require.NoError(t, err)
+
+		_, err = stakingMsgServer.BeginRedelegate(input.Ctx, stakingtypes.NewMsgBeginRedelegate(delegator.String(), general.String(), volunteerAddress.String(), amount))
+		require.ErrorIs(t, err, types.ErrVolunteerValidatorDelegation)
+
+		// the volunteer validator can still delegate to itself
+		_, err = stakingMsgServer.Delegate(input.Ctx, stakingtypes.NewMsgDelegate(sdk.AccAddress(volunteerAddress).String(), volunteerAddress.String(), amount))
+		require.NoError(t, err)
+	})
+
+	t.Run("authz exec", func(t *testing.T) {
+		expiration := input.Ctx.BlockTime().Add(time.Hour)
+		require.NoError(t, input.AuthzKeeper.SaveGrant(input.Ctx, grantee, delegator, authz.NewGenericAuthorization(sdk.MsgTypeURL(msgDelegate)), &expiration))
+
+		msgExec := authz.NewMsgExec(grantee, []sdk.Msg{msgDelegate})
+		_, err := input.AuthzKeeper.Exec(input.Ctx, &msgExec)
+		require.ErrorIs(t, err, types.ErrVolunteerValidatorDelegation)
+	})
+
+	t.Run("staking precompile", func(t *testing.T) {
+		precompile := stakingprecompile.NewPrecompile(
+			stakingKeeper,
+			stakingMsgServer,
+			sdkstakingkeeper.NewQuerier(&stakingKeeper),
+			input.BankKeeper,
+			input.AccountKeeper.AddressCodec(),
+		)
+
+		delegatorHex := common.BytesToAddress(delegator)
+		contract := vm.NewContract(delegatorHex, common.HexToAddress(evmtypes.StakingPrecompileAddress), nil, 1_000_000, nil)
+		method := precompile.Methods[stakingprecompile.DelegateMethod]
+
+		_, err := precompile.Delegate(input.Ctx, contract, nil, &method, []interface{}{delegatorHex, volunteerAddress.String(), amount.Amount.BigInt()})
+		require.ErrorIs(t, err, types.ErrVolunteerValidatorDelegation)
+	})
+}
+
+func TestPendingRegistration(t *testing.T) {
+	input := fixture.Branch(t)
+	hooks := volunteerkeeper.NewGovHooksForVolunteer(input.VolunteerKeeper, govkeeper.NewQueryServer(input.GovKeeper))
+
+	proposer := sdk.AccAddress(testutil.Pks[7].Address())
+	msg := newMsgRegisterVolunteerValidator(t, &input, 7, 1)
+	msg.OperatorContact = "contact@volunteer.xyz"
+	valAddress := sdk.ValAddress(testutil.Pks[7].Address())
+
+	submit := func() uint64 {
+		proposal, err := input.GovKeeper.SubmitProposal(input.Ctx, []sdk.Msg{msg}, "", "volunteer", "volunteer", proposer, false)
+		require.NoError(t, err)
+
+		// the registration is kept on submission
+		requirePending(t, &input, valAddress, proposal.Id, true)
+
+		return proposal.Id
+	}
+
+	setStatus := func(proposalID uint64, status govv1types.ProposalStatus) {
+		proposal, err := input.GovKeeper.Proposals.Get(input.Ctx, proposalID)
+		require.NoError(t, err)
+		proposal.Status = status
+		require.NoError(t, input.GovKeeper.SetProposal(input.Ctx, proposal))
+	}
+
+	// failed min deposit clears the pending registration
+	proposalID := submit()
+	require.NoError(t, hooks.AfterProposalFailedMinDeposit(input.Ctx, proposalID))
+	requirePending(t, &input, valAddress, proposalID, false)
+
+	// rejected proposal clears the pending registration
+	proposalID = submit()
+	setStatus(proposalID, govv1types.StatusRejected)
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(input.Ctx, proposalID))
+	requirePending(t, &input, valAddress, proposalID, false)
+
+	// a later proposal does not overwrite the earlier one and its failure
+	// clears only its own registration
+	proposalID = submit()
+	spamProposalID := submit()
+	require.NoError(t, hooks.AfterProposalFailedMinDeposit(input.Ctx, spamProposalID))
+	requirePending(t, &input, valAddress, spamProposalID, false)
+	requirePending(t, &input, valAddress, proposalID, true)
+
+	// passed proposal registers the volunteer validator with its proposal id
+	_, err := volunteerkeeper.NewMsgServerImpl(input.VolunteerKeeper).RegisterVolunteerValidator(input.Ctx, msg)
+	require.NoError(t, err)
+	setStatus(proposalID, govv1types.StatusPassed)
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(input.Ctx, proposalID))
+	requirePending(t, &input, valAddress, proposalID, false)
+
+	volunteerValidator, err := input.VolunteerKeeper.GetVolunteerValidator(input.Ctx, valAddress)
+	require.NoError(t, err)
+	require.Equal(t, proposalID, volunteerValidator.ProposalId)
+	require.Equal(t, msg.OperatorContact, volunteerValidator.OperatorContact)
+	require.Equal(t, input.Ctx.BlockHeight(), volunteerValidator.RegistrationHeight)
+
+	pendingRegistrations, err := input.VolunteerKeeper.GetPendingRegistrations(input.Ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingRegistrations)
+}
+
+func requirePending(t *testing.T, input *testutil.TestInput, valAddress sdk.ValAddress, proposalID uint64, expected bool) {
+	found, err := input.VolunteerKeeper.HasPendingRegistration(input.Ctx, valAddress, proposalID)
+	require.NoError(t, err)
+	require.Equal(t, expected, found)
+}
+
+func TestQueries(t *testing.T) {
+	input := fixture.Branch(t)
+	querier := volunteerkeeper.Querier{Keeper: input.VolunteerKeeper}
+
+	registerVolunteerValidator(t, &input, 6)
+	valAddress := registerVolunteerValidator(t, &input, 7)
+
+	volunteerValidators, err := input.VolunteerKeeper.GetVolunteerValidators(input.Ctx)
+	require.NoError(t, err)
+	require.Len(t, volunteerValidators, 2)
+
+	// volunteer validators are paginated
+	var nextKey []byte
+	addresses := []string{}
+	for {
+		res, err := querier.VolunteerValidators(input.Ctx, &types.QueryVolunteerValidatorsRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Validators, 1)
+		require.Equal(t, res.VolunteerValidators, []string{res.Validators[0].Address})
+
+		addresses = append(addresses, res.VolunteerValidators...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Len(t, addresses, len(volunteerValidators))
+
+	// volunteer validator by address
+	res, err := querier.VolunteerValidator(input.Ctx, &types.QueryVolunteerValidatorRequest{ValidatorAddress: valAddress.String()})
+	require.NoError(t, err)
+	require.Equal(t, valAddress.String(), res.Validator.Address)
+	require.Equal(t, volunteerValidators[valAddress.String()], res.Validator)
+
+	_, err = querier.VolunteerValidator(input.Ctx, &types.QueryVolunteerValidatorRequest{ValidatorAddress: sdk.ValAddress(testutil.Pks[8].Address()).String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = querier.VolunteerValidator(input.Ctx, &types.QueryVolunteerValidatorRequest{ValidatorAddress: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCommissionDestination(t *testing.T) {
+	input := fixture.Branch(t)
+	msgServer := volunteerkeeper.NewMsgServerImpl(input.VolunteerKeeper)
+
+	valAddress := registerVolunteerValidator(t, &input, 7)
+	recipient := sdk.AccAddress(testutil.Pks[9].Address())
+	commission := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	updateDestination := func(destination types.CommissionDestination) error {
+		_, err := msgServer.UpdateCommissionDestination(input.Ctx, &types.MsgUpdateCommissionDestination{
+			Authority:             input.VolunteerKeeper.GetAuthority(),
+			ValidatorAddress:      valAddress.String(),
+			CommissionDestination: destination,
+		})
+		return err
+	}
+
+	allocateCommission := func() {
+		funder := sdk.AccAddress(testutil.Pks[testutil.TempIndex].Address())
+		require.NoError(t, input.InitAccountWithCoins(funder, commission))
+		require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(input.Ctx, funder, distrtypes.ModuleName, commission))
+
+		validator, err := input.StakingKeeper.GetValidator(input.Ctx, valAddress)
+		require.NoError(t, err)
+		require.NoError(t, input.DistrKeeper.AllocateTokensToValidator(input.Ctx, validator, sdk.NewDecCoinsFromCoins(commission...)))
+	}
+
+	// the destination address is validated
+	err := updateDestination(types.NewCommissionDestination(types.CommissionDestinationAccount, "invalid"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	err = updateDestination(types.NewCommissionDestination(types.CommissionDestinationAccount, authtypes.NewModuleAddress(distrtypes.ModuleName).String()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the commission is sent to the destination account
+	require.NoError(t, updateDestination(types.NewCommissionDestination(types.CommissionDestinationAccount, recipient.String())))
+
+	allocateCommission()
+	recipientBalance := input.BankKeeper.GetBalance(input.Ctx, recipient, sdk.DefaultBondDenom)
+	require.NoError(t, volunteer.BeginBlocker(input.Ctx, input.VolunteerKeeper))
+	require.Equal(t, recipientBalance.Add(commission[0]), input.BankKeeper.GetBalance(input.Ctx, recipient, sdk.DefaultBondDenom))
+
+	// the commission is sent to the reward pool
+	require.NoError(t, updateDestination(types.NewCommissionDestination(types.CommissionDestinationRewardPool, "")))
+
+	allocateCommission()
+	pool := input.RewardKeeper.PoolBalances(input.Ctx)
+	require.NoError(t, volunteer.BeginBlocker(input.Ctx, input.VolunteerKeeper))
+	require.Equal(t, pool.Add(commission...), input.RewardKeeper.PoolBalances(input.Ctx))
+	require.Equal(t, recipientBalance.Add(commission[0]), input.BankKeeper.GetBalance(input.Ctx, recipient, sdk.DefaultBondDenom))
+}
@@ -0,0 +1,100 @@
+package bank
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface. The token
+// registry commands are added to the cosmos-sdk bank commands.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	options := am.AppModule.AutoCLIOptions()
+	if options.Query.SubCommands == nil {
+		options.Query.SubCommands = make(map[string]*autocliv1.ServiceCommandDescriptor)
+	}
+
+	options.Query.SubCommands["tokens"] = &autocliv1.ServiceCommandDescriptor{
+		Service: "xpla.bank.v1beta1.Query",
+		Short:   "Querying commands for erc20 and cw20 tokens",
+		RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+			{
+				RpcMethod: "RegisteredTokens",
+				Use:       "registered",
+				Short:     "Query the erc20 and cw20 tokens of the token registry",
+			},
+			{
+				RpcMethod:      "AllBalances",
+				Use:            "balances [address]",
+				Short:          "Query the balances of all native coins and registered tokens of an account",
+				Example:        "$ xplad query bank tokens balances xpla1...",
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+			},
+			{
+				RpcMethod: "Allowance",
+				Use:       "allowance [owner] [spender] [denom]",
+				Short:     "Query the allowance of a spender over the erc20 or cw20 token of an owner",
+				Example:   "$ xplad query bank tokens allowance xpla1... xpla1... xcw20:xpla1...",
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+					{ProtoField: "owner"},
+					{ProtoField: "spender"},
+					{ProtoField: "denom"},
+				},
+			},
+			{
+				RpcMethod:      "ContractTokenStatus",
+				Use:            "contract-status [contract-address]",
+				Short:          "Query whether a contract answers the view methods of an erc20 or cw20 token",
+				Example:        "$ xplad query bank tokens contract-status 0x...",
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}},
+			},
+		},
+	}
+
+	if options.Tx.SubCommands == nil {
+		options.Tx.SubCommands = make(map[string]*autocliv1.ServiceCommandDescriptor)
+	}
+
+	options.Tx.SubCommands["tokens"] = &autocliv1.ServiceCommandDescriptor{
+		Service: "xpla.bank.v1beta1.Msg",
+		Short:   "Transaction commands for erc20 and cw20 tokens",
+		RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+			{
+				RpcMethod: "RegisterToken",
+				Skip:      true, // only executable by governance
+			},
+			{
+				RpcMethod: "UnregisterToken",
+				Skip:      true, // only executable by governance
+			},
+			{
+				RpcMethod:      "RefreshTokenMetadata",
+				Use:            "refresh-metadata [denom]",
+				Short:          "Fetch the metadata of a registered token from its contract again",
+				Example:        "$ xplad tx bank tokens refresh-metadata xcw20:xpla1... --from mykey",
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+			},
+			{
+				RpcMethod: "Approve",
+				Use:       "approve [spender] [amount]",
+				Short:     "Set the allowances of a spender over erc20 or cw20 tokens, a zero amount revokes the allowance",
+				Example:   "$ xplad tx bank tokens approve xpla1... 1000xcw20:xpla1... --from mykey",
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+					{ProtoField: "spender"},
+					{ProtoField: "amount", Varargs: true},
+				},
+			},
+			{
+				RpcMethod: "SendFrom",
+				Use:       "send-from [owner] [to_address] [amount]",
+				Short:     "Send erc20 or cw20 tokens of an owner within the allowances of the signer",
+				Example:   "$ xplad tx bank tokens send-from xpla1... xpla1... 1000xcw20:xpla1... --from mykey",
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+					{ProtoField: "owner"},
+					{ProtoField: "to_address"},
+					{ProtoField: "amount", Varargs: true},
+				},
+			},
+		},
+	}
+
+	return options
+}
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.4.16;
+
+/**
+ * @dev Interface of the burn extension of the ERC-20 standard, as implemented
+ * by OpenZeppelin's ERC20Burnable.
+ */
+interface IERC20Burnable {
+    /**
+     * @dev Destroys a `value` amount of tokens from the caller.
+     */
+    function burn(uint256 value) external;
+}
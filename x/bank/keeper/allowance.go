@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/xpladev/xpla/x/bank/types"
+)
+
+// GetAllowance returns the allowance of the spender over the erc20 or cw20
+// token of the owner.
+func (k Keeper) GetAllowance(goCtx context.Context, owner, spender sdk.AccAddress, denom string) (sdk.Coin, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tokenType, address := types.ParseDenom(denom)
+	switch tokenType {
+	case types.Erc20:
+		return k.bek.GetAllowance(ctx, owner, spender, address)
+	case types.Cw20:
+		return k.bck.GetAllowance(ctx, owner, spender, address)
+	default:
+		return sdk.Coin{}, types.ErrInvalidToken.Wrapf("not an erc20 or cw20 denom: %s", denom)
+	}
+}
+
+// Approve sets the allowances of the spender over the erc20 and cw20 tokens of
+// the owner. Either all allowances are set or none of them is applied.
+func (k Keeper) Approve(ctx context.Context, owner, spender sdk.AccAddress, amt sdk.Coins) error {
+	evmCoins, cw20Coins, cosmosCoins := splitCoinsByTokenType(amt)
+	if !cosmosCoins.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("only erc20 and cw20 tokens have allowances: %s", cosmosCoins)
+	}
+
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	if err := k.bek.Approve(cacheCtx, owner, spender, evmCoins); err != nil {
+		return err
+	}
+	if err := k.bck.Approve(cacheCtx, owner, spender, cw20Coins); err != nil {
+		return err
+	}
+
+	write()
+
+	return nil
+}
+
+// SendCoinsFrom transfers erc20 and cw20 tokens of fromAddr to toAddr within
+// the allowances of the spender. Either all transfers succeed or none of them
+// is applied.
+func (k Keeper) SendCoinsFrom(ctx context.Context, spender, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	evmCoins, cw20Coins, cosmosCoins := splitCoinsByTokenType(amt)
+	if !cosmosCoins.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("only erc20 and cw20 tokens have allowances: %s", cosmosCoins)
+	}
+
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	if err := k.bek.SendCoinsFrom(cacheCtx, spender, fromAddr, toAddr, evmCoins); err != nil {
+		return err
+	}
+	if err := k.bck.SendCoinsFrom(cacheCtx, spender, fromAddr, toAddr, cw20Coins); err != nil {
+		return err
+	}
+
+	write()
+
+	return nil
+}
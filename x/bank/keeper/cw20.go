@@ -22,51 +22,106 @@ func NewCw20Keeper(wk types.WasmKeeper, wmk types.WasmMsgServer) Cw20Keeper {
 }
 
 func (ck Cw20Keeper) QueryTokenInfo(goCtx context.Context, contractAddress sdk.AccAddress) (*types.TokenInfoResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	var response types.TokenInfoResponse
+	if err := ck.query(goCtx, contractAddress, "token_info", types.QueryMsg_TokenInfo{}, &response); err != nil {
+		return nil, types.ErrCw20TokenInfo.Wrap(err.Error())
+	}
 
-	rawQueryData, err := json.Marshal(map[string]any{"token_info": types.QueryMsg_TokenInfo{}})
-	if err != nil {
-		return nil, err
+	return &response, nil
+}
+
+func (ck Cw20Keeper) QueryBalance(goCtx context.Context, contractAddress sdk.AccAddress, req *types.QueryMsg_Balance) (*types.BalanceResponse, error) {
+	var response types.BalanceResponse
+	if err := ck.query(goCtx, contractAddress, "balance", req, &response); err != nil {
+		return nil, types.ErrCw20Balance.Wrap(err.Error())
+	}
+
+	return &response, nil
+}
+
+func (ck Cw20Keeper) QueryAllowance(goCtx context.Context, contractAddress sdk.AccAddress, req *types.QueryMsg_Allowance) (*types.AllowanceResponse, error) {
+	var response types.AllowanceResponse
+	if err := ck.query(goCtx, contractAddress, "allowance", req, &response); err != nil {
+		return nil, types.ErrCw20Allowance.Wrap(err.Error())
 	}
 
-	rawResponseData, err := ck.wk.QuerySmart(ctx, contractAddress, rawQueryData)
+	return &response, nil
+}
+
+// query runs a smart query of the token contract under the gas limit of
+// contract queries and decodes its response.
+func (ck Cw20Keeper) query(goCtx context.Context, contractAddress sdk.AccAddress, method string, req any, response any) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rawQueryData, err := json.Marshal(map[string]any{method: req})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	var response types.TokenInfoResponse
-	if err := json.Unmarshal(rawResponseData, &response); err != nil {
-		return nil, err
+	var rawResponseData []byte
+	err = runWithGasCap(ctx, func(ctx sdk.Context) (err error) {
+		rawResponseData, err = ck.wk.QuerySmart(ctx, contractAddress, rawQueryData)
+		return err
+	})
+	if err != nil {
+		return err
 	}
 
-	return &response, nil
+	return json.Unmarshal(rawResponseData, response)
 }
 
-func (ck Cw20Keeper) QueryBalance(goCtx context.Context, contractAddress sdk.AccAddress, req *types.QueryMsg_Balance) (*types.BalanceResponse, error) {
+func (ck Cw20Keeper) ExecuteTransfer(goCtx context.Context, sender sdk.AccAddress, contractAddress sdk.AccAddress, req *types.ExecuteMsg_Transfer) (*wasmtypes.MsgExecuteContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	rawQueryData, err := json.Marshal(map[string]any{"balance": req})
+	rawExecuteData, err := json.Marshal(map[string]any{"transfer": req})
 	if err != nil {
 		return nil, err
 	}
 
-	rawResponseData, err := ck.wk.QuerySmart(ctx, contractAddress, rawQueryData)
+	msg := &wasmtypes.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: contractAddress.String(),
+		Msg:      rawExecuteData,
+		Funds:    sdk.NewCoins(),
+	}
+
+	return ck.wmk.ExecuteContract(ctx, msg)
+}
+
+func (ck Cw20Keeper) ExecuteBurn(goCtx context.Context, sender sdk.AccAddress, contractAddress sdk.AccAddress, req *types.ExecuteMsg_Burn) (*wasmtypes.MsgExecuteContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rawExecuteData, err := json.Marshal(map[string]any{"burn": req})
 	if err != nil {
 		return nil, err
 	}
 
-	var response types.BalanceResponse
-	if err := json.Unmarshal(rawResponseData, &response); err != nil {
-		return nil, err
+	msg := &wasmtypes.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: contractAddress.String(),
+		Msg:      rawExecuteData,
+		Funds:    sdk.NewCoins(),
 	}
 
-	return &response, nil
+	return ck.wmk.ExecuteContract(ctx, msg)
 }
 
-func (ck Cw20Keeper) ExecuteTransfer(goCtx context.Context, sender sdk.AccAddress, contractAddress sdk.AccAddress, req *types.ExecuteMsg_Transfer) (*wasmtypes.MsgExecuteContractResponse, error) {
+func (ck Cw20Keeper) ExecuteIncreaseAllowance(goCtx context.Context, sender sdk.AccAddress, contractAddress sdk.AccAddress, req *types.ExecuteMsg_IncreaseAllowance) (*wasmtypes.MsgExecuteContractResponse, error) {
+	return ck.execute(goCtx, sender, contractAddress, "increase_allowance", req)
+}
+
+func (ck Cw20Keeper) ExecuteDecreaseAllowance(goCtx context.Context, sender sdk.AccAddress, contractAddress sdk.AccAddress, req *types.ExecuteMsg_DecreaseAllowance) (*wasmtypes.MsgExecuteContractResponse, error) {
+	return ck.execute(goCtx, sender, contractAddress, "decrease_allowance", req)
+}
+
+func (ck Cw20Keeper) ExecuteTransferFrom(goCtx context.Context, sender sdk.AccAddress, contractAddress sdk.AccAddress, req *types.ExecuteMsg_TransferFrom) (*wasmtypes.MsgExecuteContractResponse, error) {
+	return ck.execute(goCtx, sender, contractAddress, "transfer_from", req)
+}
+
+func (ck Cw20Keeper) execute(goCtx context.Context, sender sdk.AccAddress, contractAddress sdk.AccAddress, method string, req any) (*wasmtypes.MsgExecuteContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	rawExecuteData, err := json.Marshal(map[string]any{"transfer": req})
+	rawExecuteData, err := json.Marshal(map[string]any{method: req})
 	if err != nil {
 		return nil, err
 	}
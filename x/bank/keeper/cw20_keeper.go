@@ -23,21 +23,27 @@ func NewBaseCw20Keeper(wk types.WasmKeeper, wmk types.WasmMsgServer) BaseCw20Kee
 	}
 }
 
-func (k BaseCw20Keeper) GetSupply(goCtx context.Context, contractAddress string) sdk.Coin {
+// GetSupply returns the total supply of the cw20 token, or the error of the
+// contract query.
+func (k BaseCw20Keeper) GetSupply(goCtx context.Context, contractAddress string) (sdk.Coin, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	tokenContractAddress := sdk.MustAccAddressFromBech32(contractAddress)
+	tokenContractAddress, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
 	tokenInfo, err := k.cw20keeper.QueryTokenInfo(ctx, tokenContractAddress)
 	if err != nil {
-		return types.NewCw20Coin(contractAddress, sdkmath.ZeroInt())
+		return sdk.Coin{}, err
 	}
 
 	totalSupply, ok := sdkmath.NewIntFromString(string(tokenInfo.TotalSupply))
 	if !ok {
-		return types.NewCw20Coin(contractAddress, sdkmath.ZeroInt())
+		return sdk.Coin{}, types.ErrCw20TokenInfo.Wrapf("invalid total supply: %s", tokenInfo.TotalSupply)
 	}
 
-	return types.NewCw20Coin(contractAddress, totalSupply)
+	return types.NewCw20Coin(contractAddress, totalSupply), nil
 }
 
 type Cw20SendKeeper struct {
@@ -72,27 +78,184 @@ func (k Cw20SendKeeper) SendCoins(goCtx context.Context, fromAddr sdk.AccAddress
 	return nil
 }
 
+// BurnCoins burns cw20 tokens held by the address.
+func (k Cw20SendKeeper) BurnCoins(goCtx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, coin := range amt {
+		tokenType, address := types.ParseDenom(coin.Denom)
+		if tokenType != types.Cw20 {
+			return sdkerrors.ErrInvalidCoins.Wrapf("it should be cw20 token: %s", coin.String())
+		}
+
+		contractAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+		burnMsg := &types.ExecuteMsg_Burn{
+			Amount: types.Uint128(coin.Amount.String()),
+		}
+		if _, err := k.cw20keeper.ExecuteBurn(ctx, addr, contractAddress, burnMsg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Approve sets the allowances of the spender over the cw20 tokens of the
+// owner. The cw20 contract only adjusts allowances, so the difference to the
+// current allowance is increased or decreased and the allowance is set to
+// never expire. An expired allowance is still counted by the contract, so it
+// is removed first.
+func (k Cw20SendKeeper) Approve(goCtx context.Context, owner, spender sdk.AccAddress, amt sdk.Coins) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	never := &types.Expiration{Never: &types.Expiration_Never{}}
+
+	for _, coin := range amt {
+		tokenType, address := types.ParseDenom(coin.Denom)
+		if tokenType != types.Cw20 {
+			return sdkerrors.ErrInvalidCoins.Wrapf("it should be cw20 token: %s", coin.String())
+		}
+
+		contractAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+
+		allowanceReq := &types.QueryMsg_Allowance{
+			Owner:   owner.String(),
+			Spender: spender.String(),
+		}
+		allowanceResp, err := k.cw20keeper.QueryAllowance(ctx, contractAddress, allowanceReq)
+		if err != nil {
+			return err
+		}
+
+		allowance, ok := sdkmath.NewIntFromString(string(allowanceResp.Allowance))
+		if !ok {
+			return types.ErrCw20Allowance.Wrapf("invalid allowance: %s", allowanceResp.Allowance)
+		}
+
+		if allowanceResp.Expires.IsExpired(ctx) && allowance.IsPositive() {
+			resetMsg := &types.ExecuteMsg_DecreaseAllowance{
+				Spender: spender.String(),
+				Amount:  types.Uint128(allowance.String()),
+			}
+			if _, err := k.cw20keeper.ExecuteDecreaseAllowance(ctx, owner, contractAddress, resetMsg); err != nil {
+				return err
+			}
+			allowance = sdkmath.ZeroInt()
+		}
+
+		switch {
+		case coin.Amount.GT(allowance):
+			increaseMsg := &types.ExecuteMsg_IncreaseAllowance{
+				Spender: spender.String(),
+				Amount:  types.Uint128(coin.Amount.Sub(allowance).String()),
+				Expires: never,
+			}
+			if _, err := k.cw20keeper.ExecuteIncreaseAllowance(ctx, owner, contractAddress, increaseMsg); err != nil {
+				return err
+			}
+		case coin.Amount.LT(allowance):
+			decreaseMsg := &types.ExecuteMsg_DecreaseAllowance{
+				Spender: spender.String(),
+				Amount:  types.Uint128(allowance.Sub(coin.Amount).String()),
+				Expires: never,
+			}
+			if _, err := k.cw20keeper.ExecuteDecreaseAllowance(ctx, owner, contractAddress, decreaseMsg); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// SendCoinsFrom transfers cw20 tokens of fromAddr within the allowances of the
+// spender.
+func (k Cw20SendKeeper) SendCoinsFrom(goCtx context.Context, spender, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, coin := range amt {
+		tokenType, address := types.ParseDenom(coin.Denom)
+		if tokenType != types.Cw20 {
+			return sdkerrors.ErrInvalidCoins.Wrapf("it should be cw20 token: %s", coin.String())
+		}
+
+		contractAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+		transferFromMsg := &types.ExecuteMsg_TransferFrom{
+			Owner:     fromAddr.String(),
+			Recipient: toAddr.String(),
+			Amount:    types.Uint128(coin.Amount.String()),
+		}
+		if _, err := k.cw20keeper.ExecuteTransferFrom(ctx, spender, contractAddress, transferFromMsg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type Cw20ViewKeeper struct {
 	cw20keeper Cw20Keeper
 }
 
-// GetBalance implements keeper.ViewKeeper.
-func (e Cw20ViewKeeper) GetBalance(goCtx context.Context, addr sdk.AccAddress, cw20Address string) sdk.Coin {
+// GetBalance returns the cw20 token balance of the address, or the error of
+// the contract query.
+func (e Cw20ViewKeeper) GetBalance(goCtx context.Context, addr sdk.AccAddress, cw20Address string) (sdk.Coin, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	contractAddress := sdk.MustAccAddressFromBech32(cw20Address)
+	contractAddress, err := sdk.AccAddressFromBech32(cw20Address)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
 
 	balanceReq := &types.QueryMsg_Balance{
 		Address: addr.String(),
 	}
 	balanceResp, err := e.cw20keeper.QueryBalance(ctx, contractAddress, balanceReq)
 	if err != nil {
-		return types.NewCw20Coin(cw20Address, sdkmath.ZeroInt())
+		return sdk.Coin{}, err
 	}
 
 	amount, ok := sdkmath.NewIntFromString(string(balanceResp.Balance))
 	if !ok {
-		return types.NewCw20Coin(cw20Address, sdkmath.ZeroInt())
+		return sdk.Coin{}, types.ErrCw20Balance.Wrapf("invalid balance: %s", balanceResp.Balance)
+	}
+
+	return types.NewCw20Coin(cw20Address, amount), nil
+}
+
+// GetAllowance returns the cw20 allowance of the spender over the tokens of
+// the owner. An expired allowance is returned as zero.
+func (e Cw20ViewKeeper) GetAllowance(goCtx context.Context, owner, spender sdk.AccAddress, cw20Address string) (sdk.Coin, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contractAddress, err := sdk.AccAddressFromBech32(cw20Address)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	allowanceReq := &types.QueryMsg_Allowance{
+		Owner:   owner.String(),
+		Spender: spender.String(),
+	}
+	allowanceResp, err := e.cw20keeper.QueryAllowance(ctx, contractAddress, allowanceReq)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if allowanceResp.Expires.IsExpired(ctx) {
+		return types.NewCw20Coin(cw20Address, sdkmath.ZeroInt()), nil
+	}
+
+	amount, ok := sdkmath.NewIntFromString(string(allowanceResp.Allowance))
+	if !ok {
+		return sdk.Coin{}, types.ErrCw20Allowance.Wrapf("invalid allowance: %s", allowanceResp.Allowance)
 	}
 
-	return types.NewCw20Coin(cw20Address, amount)
+	return types.NewCw20Coin(cw20Address, amount), nil
 }
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"bytes"
+	"fmt"
 	"math/big"
 
 	_ "embed"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,16 +15,28 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/xpladev/xpla/x/bank/types"
 )
 
 var (
-	ABI = abi.ABI{}
+	ABI         = abi.ABI{}
+	BurnableABI = abi.ABI{}
+	MetadataABI = abi.ABI{}
 
 	//go:embed IERC20.json
 	f []byte
+
+	//go:embed IERC20Burnable.json
+	burnableF []byte
+
+	//go:embed IERC20Metadata.json
+	metadataF []byte
 )
 
 type Erc20Keeper struct {
@@ -36,6 +50,16 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
+	BurnableABI, err = abi.JSON(bytes.NewReader(burnableF))
+	if err != nil {
+		panic(err)
+	}
+
+	MetadataABI, err = abi.JSON(bytes.NewReader(metadataF))
+	if err != nil {
+		panic(err)
+	}
 }
 
 func NewErc20Keeper(ak banktypes.AccountKeeper, ek types.EvmKeeper) Erc20Keeper {
@@ -46,54 +70,130 @@ func NewErc20Keeper(ak banktypes.AccountKeeper, ek types.EvmKeeper) Erc20Keeper
 }
 
 func (k Erc20Keeper) QueryTotalSupply(ctx sdk.Context, contractAddress common.Address) (sdkmath.Int, error) {
-	moduleAccount := k.ak.GetModuleAccount(ctx, banktypes.ModuleName)
-	moduleAddress := common.BytesToAddress(moduleAccount.GetAddress().Bytes())
+	value, err := k.queryContract(ctx, ABI, contractAddress, types.TotalSupply)
+	if err != nil {
+		return sdkmath.ZeroInt(), types.ErrErc20TotalSupply.Wrap(err.Error())
+	}
 
-	stateDB := statedb.New(ctx, k.ek, statedb.NewEmptyTxConfig())
-	res, err := k.ek.CallEVM(ctx, stateDB, ABI, moduleAddress, contractAddress, false, false, nil, types.GetErc20Method(types.TotalSupply))
+	bigTotalSupply, ok := value.(*big.Int)
+	if !ok {
+		return sdkmath.ZeroInt(), types.ErrErc20TotalSupply.Wrap("invalid total supply")
+	}
+
+	return sdkmath.NewIntFromBigInt(bigTotalSupply), nil
+}
+
+func (k Erc20Keeper) QueryBalanceOf(ctx sdk.Context, contractAddress common.Address, account sdk.AccAddress) (sdkmath.Int, error) {
+	ethAccount := common.BytesToAddress(account.Bytes())
+
+	value, err := k.queryContract(ctx, ABI, contractAddress, types.BalanceOf, ethAccount)
 	if err != nil {
-		return sdkmath.ZeroInt(), err
+		return sdkmath.ZeroInt(), types.ErrErc20Balance.Wrap(err.Error())
+	}
+
+	bigBalance, ok := value.(*big.Int)
+	if !ok {
+		return sdkmath.ZeroInt(), types.ErrErc20Balance.Wrap("invalid balance")
 	}
 
-	unpacked, err := ABI.Unpack(types.GetErc20Method(types.TotalSupply), res.Return())
-	if err != nil || len(unpacked) == 0 {
-		return sdkmath.ZeroInt(), err
+	return sdkmath.NewIntFromBigInt(bigBalance), nil
+}
+
+func (k Erc20Keeper) QueryAllowance(ctx sdk.Context, contractAddress common.Address, owner, spender sdk.AccAddress) (sdkmath.Int, error) {
+	ethOwner := common.BytesToAddress(owner.Bytes())
+	ethSpender := common.BytesToAddress(spender.Bytes())
+
+	value, err := k.queryContract(ctx, ABI, contractAddress, types.Allowance, ethOwner, ethSpender)
+	if err != nil {
+		return sdkmath.ZeroInt(), types.ErrErc20Allowance.Wrap(err.Error())
 	}
 
-	bigTotalSupply, ok := unpacked[0].(*big.Int)
+	bigAllowance, ok := value.(*big.Int)
 	if !ok {
-		return sdkmath.ZeroInt(), types.ErrErc20TotalSupply
+		return sdkmath.ZeroInt(), types.ErrErc20Allowance.Wrap("invalid allowance")
 	}
 
-	totalSupply := sdkmath.NewIntFromBigInt(bigTotalSupply)
+	return sdkmath.NewIntFromBigInt(bigAllowance), nil
+}
+
+// QueryMetadata queries the name, symbol and decimals of the token contract.
+func (k Erc20Keeper) QueryMetadata(ctx sdk.Context, contractAddress common.Address) (*types.TokenMetadata, error) {
+	values := make(map[types.MethodErc20]interface{})
+	for _, method := range []types.MethodErc20{types.Name, types.Symbol, types.Decimals} {
+		value, err := k.queryContract(ctx, MetadataABI, contractAddress, method)
+		if err != nil {
+			return nil, types.ErrErc20Metadata.Wrapf("%s: %s", method, err)
+		}
+
+		values[method] = value
+	}
+
+	name, ok := values[types.Name].(string)
+	if !ok {
+		return nil, types.ErrErc20Metadata.Wrap("invalid name")
+	}
+	symbol, ok := values[types.Symbol].(string)
+	if !ok {
+		return nil, types.ErrErc20Metadata.Wrap("invalid symbol")
+	}
+	decimals, ok := values[types.Decimals].(uint8)
+	if !ok {
+		return nil, types.ErrErc20Metadata.Wrap("invalid decimals")
+	}
 
-	return totalSupply, nil
+	return &types.TokenMetadata{
+		Name:     name,
+		Symbol:   symbol,
+		Decimals: uint32(decimals),
+	}, nil
 }
 
-func (k Erc20Keeper) QueryBalanceOf(ctx sdk.Context, contractAddress common.Address, account sdk.AccAddress) (sdkmath.Int, error) {
+// queryContract calls a view method of the token contract from the bank module
+// account and returns the first value it returns. The evm runs the call with
+// the gas limit of contract queries, and the gas it used is charged to the
+// context.
+func (k Erc20Keeper) queryContract(ctx sdk.Context, contractABI abi.ABI, contractAddress common.Address, method types.MethodErc20, args ...interface{}) (interface{}, error) {
 	moduleAccount := k.ak.GetModuleAccount(ctx, banktypes.ModuleName)
 	moduleAddress := common.BytesToAddress(moduleAccount.GetAddress().Bytes())
-	ethAccount := common.BytesToAddress(account.Bytes())
 
-	stateDB := statedb.New(ctx, k.ek, statedb.NewEmptyTxConfig())
-	res, err := k.ek.CallEVM(ctx, stateDB, ABI, moduleAddress, contractAddress, false, false, nil, types.GetErc20Method(types.BalanceOf), ethAccount)
+	data, err := contractABI.Pack(types.GetErc20Method(method), args...)
 	if err != nil {
-		return sdkmath.ZeroInt(), err
+		return nil, err
 	}
 
-	unpacked, err := ABI.Unpack(types.GetErc20Method(types.BalanceOf), res.Return())
-	if err != nil || len(unpacked) == 0 {
-		return sdkmath.ZeroInt(), err
+	msg := core.Message{
+		From:       moduleAddress,
+		To:         &contractAddress,
+		Nonce:      k.ek.GetNonce(ctx, moduleAddress),
+		Value:      big.NewInt(0),
+		GasLimit:   types.ContractQueryGasLimit,
+		GasPrice:   big.NewInt(0),
+		GasTipCap:  big.NewInt(0),
+		GasFeeCap:  big.NewInt(0),
+		Data:       data,
+		AccessList: ethtypes.AccessList{},
 	}
 
-	bigBalance, ok := unpacked[0].(*big.Int)
-	if !ok {
-		return sdkmath.ZeroInt(), types.ErrErc20Balance
+	stateDB := statedb.New(ctx, k.ek, statedb.NewEmptyTxConfig())
+	res, err := k.ek.ApplyMessage(ctx, stateDB, msg, nil, false, false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "erc20 contract query")
+	if res.Failed() {
+		return nil, errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
 	}
 
-	balance := sdkmath.NewIntFromBigInt(bigBalance)
+	unpacked, err := contractABI.Unpack(types.GetErc20Method(method), res.Return())
+	if err != nil {
+		return nil, err
+	}
+	if len(unpacked) == 0 {
+		return nil, fmt.Errorf("empty return value of %s", method)
+	}
 
-	return balance, nil
+	return unpacked[0], nil
 }
 
 func (k Erc20Keeper) ExecuteTransfer(ctx sdk.Context, contractAddress common.Address, sender, to sdk.AccAddress, amount *big.Int) error {
@@ -117,3 +217,86 @@ func (k Erc20Keeper) ExecuteTransfer(ctx sdk.Context, contractAddress common.Add
 
 	return nil
 }
+
+func (k Erc20Keeper) ExecuteApprove(ctx sdk.Context, contractAddress common.Address, owner, spender sdk.AccAddress, amount *big.Int) error {
+	ethOwner := common.BytesToAddress(owner.Bytes())
+	ethSpender := common.BytesToAddress(spender.Bytes())
+
+	stateDB := statedb.New(ctx, k.ek, statedb.NewEmptyTxConfig())
+	res, err := k.ek.CallEVM(ctx, stateDB, ABI, ethOwner, contractAddress, true, false, nil, types.GetErc20Method(types.Approve), ethSpender, amount)
+	if err != nil {
+		return err
+	}
+
+	unpacked, err := ABI.Unpack(types.GetErc20Method(types.Approve), res.Return())
+	if err != nil {
+		return err
+	}
+
+	if len(unpacked) == 0 || !unpacked[0].(bool) {
+		return types.ErrErc20Approve
+	}
+
+	return nil
+}
+
+func (k Erc20Keeper) ExecuteTransferFrom(ctx sdk.Context, contractAddress common.Address, spender, from, to sdk.AccAddress, amount *big.Int) error {
+	ethSpender := common.BytesToAddress(spender.Bytes())
+	ethFrom := common.BytesToAddress(from.Bytes())
+	ethTo := common.BytesToAddress(to.Bytes())
+
+	stateDB := statedb.New(ctx, k.ek, statedb.NewEmptyTxConfig())
+	res, err := k.ek.CallEVM(ctx, stateDB, ABI, ethSpender, contractAddress, true, false, nil, types.GetErc20Method(types.TransferFrom), ethFrom, ethTo, amount)
+	if err != nil {
+		return err
+	}
+
+	unpacked, err := ABI.Unpack(types.GetErc20Method(types.TransferFrom), res.Return())
+	if err != nil {
+		return err
+	}
+
+	if len(unpacked) == 0 || !unpacked[0].(bool) {
+		return types.ErrErc20TransferFrom
+	}
+
+	return nil
+}
+
+// ExecuteBurn calls burn of the token contract. Unlike CallEVM, a failed call
+// only charges the gas it used, so that the caller can fall back to another
+// way of burning.
+func (k Erc20Keeper) ExecuteBurn(ctx sdk.Context, contractAddress common.Address, sender sdk.AccAddress, amount *big.Int) error {
+	ethSender := common.BytesToAddress(sender.Bytes())
+
+	data, err := BurnableABI.Pack(types.GetErc20Method(types.Burn), amount)
+	if err != nil {
+		return err
+	}
+
+	msg := core.Message{
+		From:       ethSender,
+		To:         &contractAddress,
+		Nonce:      k.ek.GetNonce(ctx, ethSender),
+		Value:      big.NewInt(0),
+		GasLimit:   config.DefaultGasCap,
+		GasPrice:   big.NewInt(0),
+		GasTipCap:  big.NewInt(0),
+		GasFeeCap:  big.NewInt(0),
+		Data:       data,
+		AccessList: ethtypes.AccessList{},
+	}
+
+	stateDB := statedb.New(ctx, k.ek, statedb.NewEmptyTxConfig())
+	res, err := k.ek.ApplyMessage(ctx, stateDB, msg, nil, true, false, true)
+	if err != nil {
+		return err
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "erc20 contract burn")
+	if res.Failed() {
+		return errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+
+	return nil
+}
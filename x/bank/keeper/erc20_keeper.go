@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -25,16 +26,18 @@ func NewBaseErc20Keeper(ak banktypes.AccountKeeper, ek types.EvmKeeper) BaseErc2
 	}
 }
 
-func (k *BaseErc20Keeper) GetSupply(goCtx context.Context, contractAddress string) sdk.Coin {
+// GetSupply returns the total supply of the erc20 token, or the error of the
+// contract query.
+func (k *BaseErc20Keeper) GetSupply(goCtx context.Context, contractAddress string) (sdk.Coin, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	tokenContractAddress := common.HexToAddress(contractAddress)
 	totalSupply, err := k.erc20keeper.QueryTotalSupply(ctx, tokenContractAddress)
 	if err != nil {
-		return types.NewErc20Coin(contractAddress, sdkmath.NewInt(0))
+		return sdk.Coin{}, err
 	}
 
-	return types.NewErc20Coin(contractAddress, totalSupply)
+	return types.NewErc20Coin(contractAddress, totalSupply), nil
 }
 
 type Erc20SendKeeper struct {
@@ -62,20 +65,131 @@ func (k *Erc20SendKeeper) SendCoins(goCtx context.Context, fromAddr sdk.AccAddre
 	return nil
 }
 
+// BurnCoins burns erc20 tokens held by the address. If burn of the contract
+// reverts or does not reduce the balance by the amount, the tokens are
+// transferred to the dead address instead, which is reported by
+// EventErc20TransferredToDeadAddress.
+func (k *Erc20SendKeeper) BurnCoins(goCtx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, coin := range amt {
+		tokenType, address := types.ParseDenom(coin.Denom)
+		if tokenType != types.Erc20 {
+			return sdkerrors.ErrInvalidCoins.Wrapf("it should be erc20 token: %s", coin.String())
+		}
+		contractAddress := common.HexToAddress(address)
+
+		if k.tryBurn(ctx, contractAddress, addr, coin.Amount) {
+			continue
+		}
+
+		if err := k.erc20keeper.ExecuteTransfer(ctx, contractAddress, addr, sdk.AccAddress(types.Erc20DeadAddress.Bytes()), coin.Amount.BigInt()); err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventErc20TransferredToDeadAddress{
+			Sender:      addr.String(),
+			Amount:      coin,
+			DeadAddress: types.Erc20DeadAddress.Hex(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tryBurn calls burn of the contract in a cache context and commits it only
+// if it reduced the balance of the address by the amount.
+func (k *Erc20SendKeeper) tryBurn(ctx sdk.Context, contractAddress common.Address, addr sdk.AccAddress, amount sdkmath.Int) bool {
+	cacheCtx, write := ctx.CacheContext()
+
+	balance, err := k.erc20keeper.QueryBalanceOf(cacheCtx, contractAddress, addr)
+	if err != nil {
+		return false
+	}
+
+	if err := k.erc20keeper.ExecuteBurn(cacheCtx, contractAddress, addr, amount.BigInt()); err != nil {
+		return false
+	}
+
+	burned, err := k.erc20keeper.QueryBalanceOf(cacheCtx, contractAddress, addr)
+	if err != nil || !balance.Sub(burned).Equal(amount) {
+		return false
+	}
+
+	write()
+	return true
+}
+
+// Approve sets the allowances of the spender over the erc20 tokens of the
+// owner.
+func (k *Erc20SendKeeper) Approve(goCtx context.Context, owner, spender sdk.AccAddress, amt sdk.Coins) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, coin := range amt {
+		tokenType, address := types.ParseDenom(coin.Denom)
+		if tokenType != types.Erc20 {
+			return sdkerrors.ErrInvalidCoins.Wrapf("it should be erc20 token: %s", coin.String())
+		}
+
+		contractAddress := common.HexToAddress(address)
+		if err := k.erc20keeper.ExecuteApprove(ctx, contractAddress, owner, spender, coin.Amount.BigInt()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendCoinsFrom transfers erc20 tokens of fromAddr within the allowances of
+// the spender.
+func (k *Erc20SendKeeper) SendCoinsFrom(goCtx context.Context, spender, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, coin := range amt {
+		tokenType, address := types.ParseDenom(coin.Denom)
+		if tokenType != types.Erc20 {
+			return sdkerrors.ErrInvalidCoins.Wrapf("it should be erc20 token: %s", coin.String())
+		}
+
+		contractAddress := common.HexToAddress(address)
+		if err := k.erc20keeper.ExecuteTransferFrom(ctx, contractAddress, spender, fromAddr, toAddr, coin.Amount.BigInt()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type Erc20ViewKeeper struct {
 	erc20keeper Erc20Keeper
 }
 
-// GetBalance implements keeper.ViewKeeper.
-func (e *Erc20ViewKeeper) GetBalance(goCtx context.Context, addr sdk.AccAddress, hexErc20Address string) sdk.Coin {
+// GetBalance returns the erc20 token balance of the address, or the error of
+// the contract query.
+func (e *Erc20ViewKeeper) GetBalance(goCtx context.Context, addr sdk.AccAddress, hexErc20Address string) (sdk.Coin, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	contractAddress := common.HexToAddress(hexErc20Address)
 
 	amount, err := e.erc20keeper.QueryBalanceOf(ctx, contractAddress, addr)
 	if err != nil {
-		panic(err)
+		return sdk.Coin{}, err
 	}
 
-	return types.NewErc20Coin(hexErc20Address, amount)
+	return types.NewErc20Coin(hexErc20Address, amount), nil
+}
+
+// GetAllowance returns the erc20 allowance of the spender over the tokens of
+// the owner.
+func (e *Erc20ViewKeeper) GetAllowance(goCtx context.Context, owner, spender sdk.AccAddress, hexErc20Address string) (sdk.Coin, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contractAddress := common.HexToAddress(hexErc20Address)
+
+	amount, err := e.erc20keeper.QueryAllowance(ctx, contractAddress, owner, spender)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
 
+	return types.NewErc20Coin(hexErc20Address, amount), nil
 }
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/xpladev/xpla/x/bank/types"
+)
+
+// runWithGasCap runs a cw20 contract query with a separate gas meter limited
+// to types.ContractQueryGasLimit and charges the gas it used to the context.
+// Running out of the limit is returned as an error instead of a panic. Erc20
+// queries pass the limit to the evm instead, which ignores the gas meter.
+func runWithGasCap(ctx sdk.Context, query func(ctx sdk.Context) error) (err error) {
+	gasMeter := storetypes.NewGasMeter(types.ContractQueryGasLimit)
+
+	defer func() {
+		r := recover()
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "contract query")
+
+		if r == nil {
+			return
+		}
+		if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+			panic(r)
+		}
+
+		err = sdkerrors.ErrOutOfGas.Wrapf("contract query exceeds the gas limit %d", types.ContractQueryGasLimit)
+	}()
+
+	return query(ctx.WithGasMeter(gasMeter))
+}
@@ -2,12 +2,18 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/xpladev/xpla/x/bank/types"
 )
 
 var _ banktypes.QueryServer = Keeper{}
@@ -29,7 +35,10 @@ func (k Keeper) Balance(ctx context.Context, req *banktypes.QueryBalanceRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
 	}
 
-	balance := k.GetBalance(sdkCtx, address, req.Denom)
+	balance, err := k.QueryBalance(sdkCtx, address, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 
 	return &banktypes.QueryBalanceResponse{Balance: &balance}, nil
 }
@@ -53,7 +62,12 @@ func (k Keeper) SpendableBalanceByDenom(ctx context.Context, req *banktypes.Quer
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	spendable := k.SpendableCoin(sdkCtx, addr, req.Denom)
+	balance, err := k.QueryBalance(sdkCtx, addr, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	spendable := balance.SubAmount(k.LockedCoins(sdkCtx, addr).AmountOf(req.Denom))
 
 	return &banktypes.QuerySpendableBalanceByDenomResponse{Balance: &spendable}, nil
 }
@@ -70,7 +84,192 @@ func (k Keeper) SupplyOf(c context.Context, req *banktypes.QuerySupplyOfRequest)
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	supply := k.GetSupply(ctx, req.Denom)
+	supply, err := k.QuerySupply(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 
 	return &banktypes.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply.Amount)}, nil
 }
+
+// Querier implements the xpla extension of the bank gRPC querier service.
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// RegisteredTokens implements the Query/RegisteredTokens gRPC method
+func (k Querier) RegisteredTokens(c context.Context, req *types.QueryRegisteredTokensRequest) (*types.QueryRegisteredTokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.RegisteredTokenKey)
+
+	tokens := []types.RegisteredToken{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var token types.RegisteredToken
+		if err := k.cdc.Unmarshal(value, &token); err != nil {
+			return err
+		}
+
+		tokens = append(tokens, token)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRegisteredTokensResponse{
+		Tokens:     tokens,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AllBalances implements the Query/AllBalances gRPC method
+func (k Querier) AllBalances(c context.Context, req *types.QueryAllBalancesRequest) (*types.QueryAllBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	tokens, err := k.GetRegisteredTokens(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// paginate the denoms first, so that only the tokens of the page are
+	// queried from their contracts
+	denoms := []string{}
+	for _, coin := range k.GetAllBalances(ctx, addr) {
+		denoms = append(denoms, coin.Denom)
+	}
+	for _, token := range tokens {
+		denoms = append(denoms, token.Denom)
+	}
+	slices.Sort(denoms)
+	denoms = slices.Compact(denoms)
+
+	page, pageRes, err := paginateDenoms(denoms, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	balances, failedTokens := k.GetBalancesWithTokens(ctx, addr, page)
+
+	return &types.QueryAllBalancesResponse{
+		Balances:     balances,
+		Pagination:   pageRes,
+		FailedTokens: failedTokens,
+	}, nil
+}
+
+// Allowance implements the Query/Allowance gRPC method
+func (k Querier) Allowance(c context.Context, req *types.QueryAllowanceRequest) (*types.QueryAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := k.ak.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err.Error())
+	}
+	spender, err := k.ak.AddressCodec().StringToBytes(req.Spender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid spender address: %s", err.Error())
+	}
+
+	if err := types.ValidateTokenDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowance, err := k.GetAllowance(ctx, owner, spender, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllowanceResponse{Allowance: allowance}, nil
+}
+
+// ContractTokenStatus implements the Query/ContractTokenStatus gRPC method
+func (k Querier) ContractTokenStatus(c context.Context, req *types.QueryContractTokenStatusRequest) (*types.QueryContractTokenStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denom, checks, err := k.CheckContractToken(ctx, req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryContractTokenStatusResponse{
+		Denom:     denom,
+		Compliant: types.IsCompliant(checks),
+		Checks:    checks,
+	}, nil
+}
+
+// paginateDenoms returns a page of the sorted denoms. The key of the page
+// request is the first denom of the page.
+func paginateDenoms(denoms []string, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if pageReq.Reverse {
+		denoms = slices.Clone(denoms)
+		slices.Reverse(denoms)
+	}
+
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	start := uint64(len(denoms))
+	if pageReq.Key != nil {
+		key := string(pageReq.Key)
+		for i, denom := range denoms {
+			if (!pageReq.Reverse && denom >= key) || (pageReq.Reverse && denom <= key) {
+				start = uint64(i)
+				break
+			}
+		}
+	} else if pageReq.Offset < start {
+		start = pageReq.Offset
+	}
+
+	end := uint64(len(denoms))
+	if limit < end-start {
+		end = start + limit
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < uint64(len(denoms)) {
+		pageRes.NextKey = []byte(denoms[end])
+	}
+	if countTotal {
+		pageRes.Total = uint64(len(denoms))
+	}
+
+	return denoms[start:end], pageRes, nil
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 
 	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/xpladev/xpla/x/bank/types"
@@ -17,6 +20,9 @@ var _ bankkeeper.Keeper = (*Keeper)(nil)
 type Keeper struct {
 	bankkeeper.BaseKeeper
 
+	storeService store.KVStoreService
+	cdc          codec.BinaryCodec
+
 	bek BaseErc20Keeper
 	bck BaseCw20Keeper
 
@@ -35,14 +41,31 @@ func NewKeeper(
 	wmk types.WasmMsgServer,
 ) Keeper {
 	return Keeper{
-		BaseKeeper: bankkeeper.NewBaseKeeper(cdc, storeService, ak, blockedAddrs, authority, logger),
-		bek:        NewBaseErc20Keeper(ak, ek),
-		bck:        NewBaseCw20Keeper(wk, wmk),
-		ak:         ak,
+		BaseKeeper:   bankkeeper.NewBaseKeeper(cdc, storeService, ak, blockedAddrs, authority, logger),
+		storeService: storeService,
+		cdc:          cdc,
+		bek:          NewBaseErc20Keeper(ak, ek),
+		bck:          NewBaseCw20Keeper(wk, wmk),
+		ak:           ak,
+	}
+}
+
+// GetBalance returns the balance of a denom for an account. It panics if the
+// query of an erc20 or cw20 contract fails, so that a broken contract never
+// reads as a zero balance in the state machine. Queries use QueryBalance to
+// get the error instead.
+func (k Keeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	balance, err := k.QueryBalance(ctx, addr, denom)
+	if err != nil {
+		panic(err)
 	}
+
+	return balance
 }
 
-func (k Keeper) GetBalance(goCtx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+// QueryBalance returns the balance of a denom for an account, or the error of
+// the erc20 or cw20 contract query.
+func (k Keeper) QueryBalance(goCtx context.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	tokenType, address := types.ParseDenom(denom)
@@ -52,40 +75,41 @@ func (k Keeper) GetBalance(goCtx context.Context, addr sdk.AccAddress, denom str
 	case types.Cw20:
 		return k.bck.GetBalance(ctx, addr, address)
 	default:
-		return k.BaseKeeper.GetBalance(ctx, addr, denom)
+		return k.BaseKeeper.GetBalance(ctx, addr, denom), nil
 	}
 }
 
-func (k Keeper) GetSupply(goCtx context.Context, denom string) sdk.Coin {
+// GetSupply returns the supply of a denom. It panics if the query of an erc20
+// or cw20 contract fails, so that a broken contract never reads as a zero
+// supply in the state machine. Queries use QuerySupply to get the error
+// instead.
+func (k Keeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	supply, err := k.QuerySupply(ctx, denom)
+	if err != nil {
+		panic(err)
+	}
+
+	return supply
+}
+
+// QuerySupply returns the supply of a denom, or the error of the erc20 or cw20
+// contract query.
+func (k Keeper) QuerySupply(goCtx context.Context, denom string) (sdk.Coin, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	tokenType, address := types.ParseDenom(denom)
 	switch tokenType {
 	case types.Erc20:
-		return k.bek.GetSupply(goCtx, address)
+		return k.bek.GetSupply(ctx, address)
 	case types.Cw20:
-		return k.bck.GetSupply(goCtx, address)
+		return k.bck.GetSupply(ctx, address)
 	default:
-		return k.BaseKeeper.GetSupply(ctx, denom)
+		return k.BaseKeeper.GetSupply(ctx, denom), nil
 	}
 }
 
 func (k Keeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	evmCoins := sdk.NewCoins()
-	cw20Coins := sdk.NewCoins()
-	cosmosCoins := sdk.NewCoins()
-
-	for _, coin := range amt {
-		tokenType, _ := types.ParseDenom(coin.Denom)
-		switch tokenType {
-		case types.Erc20:
-			evmCoins = append(evmCoins, coin)
-		case types.Cw20:
-			cw20Coins = append(cw20Coins, coin)
-		default:
-			cosmosCoins = append(cosmosCoins, coin)
-		}
-	}
+	evmCoins, cw20Coins, cosmosCoins := splitCoinsByTokenType(amt)
 
 	if err := k.bek.SendCoins(ctx, fromAddr, toAddr, evmCoins); err != nil {
 		return err
@@ -100,6 +124,55 @@ func (k Keeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress,
 	return nil
 }
 
+// InputOutputCoins performs multi-send functionality. Erc20 and cw20 tokens
+// are transferred from the input to each output by their token contracts, and
+// the other coins are sent by the cosmos bank keeper. A failed transfer fails
+// the whole multi-send, whose partial state changes are discarded with the
+// transaction.
+func (k Keeper) InputOutputCoins(ctx context.Context, input banktypes.Input, outputs []banktypes.Output) error {
+	evmCoins, cw20Coins, cosmosCoins := splitCoinsByTokenType(input.Coins)
+	if evmCoins.Empty() && cw20Coins.Empty() {
+		return k.BaseKeeper.InputOutputCoins(ctx, input, outputs)
+	}
+
+	if err := banktypes.ValidateInputOutputs(input, outputs); err != nil {
+		return err
+	}
+
+	inAddress, err := k.ak.AddressCodec().StringToBytes(input.Address)
+	if err != nil {
+		return err
+	}
+
+	cosmosOutputs := make([]banktypes.Output, 0, len(outputs))
+	for _, out := range outputs {
+		outAddress, err := k.ak.AddressCodec().StringToBytes(out.Address)
+		if err != nil {
+			return err
+		}
+
+		outEvmCoins, outCw20Coins, outCosmosCoins := splitCoinsByTokenType(out.Coins)
+		if err := k.bek.SendCoins(ctx, inAddress, outAddress, outEvmCoins); err != nil {
+			return err
+		}
+		if err := k.bck.SendCoins(ctx, inAddress, outAddress, outCw20Coins); err != nil {
+			return err
+		}
+
+		if !outCosmosCoins.Empty() {
+			cosmosOutputs = append(cosmosOutputs, banktypes.Output{Address: out.Address, Coins: outCosmosCoins})
+		}
+	}
+
+	if !cosmosCoins.Empty() {
+		if err := k.BaseKeeper.InputOutputCoins(ctx, banktypes.Input{Address: input.Address, Coins: cosmosCoins}, cosmosOutputs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (k Keeper) IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error {
 	cosmosCoins := sdk.NewCoins()
 
@@ -121,3 +194,101 @@ func (k Keeper) SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom st
 	locked := k.LockedCoins(ctx, addr)
 	return balance.SubAmount(locked.AmountOf(denom))
 }
+
+// SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
+// Copied from cosmos-sdk/x/bank/keeper/keeper.go to route erc20 and cw20 tokens
+func (k Keeper) SendCoinsFromModuleToAccount(
+	ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	senderAddr := k.ak.GetModuleAddress(senderModule)
+	if senderAddr == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+
+	if k.BlockedAddr(recipientAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
+	}
+
+	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
+}
+
+// SendCoinsFromModuleToModule transfers coins from a ModuleAccount to another.
+// Copied from cosmos-sdk/x/bank/keeper/keeper.go to route erc20 and cw20 tokens
+func (k Keeper) SendCoinsFromModuleToModule(
+	ctx context.Context, senderModule, recipientModule string, amt sdk.Coins,
+) error {
+	senderAddr := k.ak.GetModuleAddress(senderModule)
+	if senderAddr == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+
+	recipientAcc := k.ak.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+
+	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+}
+
+// SendCoinsFromAccountToModule transfers coins from an AccAddress to a ModuleAccount.
+// Copied from cosmos-sdk/x/bank/keeper/keeper.go to route erc20 and cw20 tokens
+func (k Keeper) SendCoinsFromAccountToModule(
+	ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	recipientAcc := k.ak.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+
+	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+}
+
+// BurnCoins burns coins from a module account. Erc20 and cw20 tokens are
+// burned by their token contracts.
+func (k Keeper) BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error {
+	evmCoins, cw20Coins, cosmosCoins := splitCoinsByTokenType(amounts)
+
+	if !evmCoins.Empty() || !cw20Coins.Empty() {
+		acc := k.ak.GetModuleAccount(ctx, moduleName)
+		if acc == nil {
+			panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName))
+		}
+
+		if !acc.HasPermission(authtypes.Burner) {
+			panic(errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to burn tokens", moduleName))
+		}
+
+		if err := k.bek.BurnCoins(ctx, acc.GetAddress(), evmCoins); err != nil {
+			return err
+		}
+		if err := k.bck.BurnCoins(ctx, acc.GetAddress(), cw20Coins); err != nil {
+			return err
+		}
+	}
+
+	if cosmosCoins.Empty() {
+		return nil
+	}
+
+	return k.BaseKeeper.BurnCoins(ctx, moduleName, cosmosCoins)
+}
+
+func splitCoinsByTokenType(amt sdk.Coins) (evmCoins, cw20Coins, cosmosCoins sdk.Coins) {
+	evmCoins = sdk.NewCoins()
+	cw20Coins = sdk.NewCoins()
+	cosmosCoins = sdk.NewCoins()
+
+	for _, coin := range amt {
+		tokenType, _ := types.ParseDenom(coin.Denom)
+		switch tokenType {
+		case types.Erc20:
+			evmCoins = append(evmCoins, coin)
+		case types.Cw20:
+			cw20Coins = append(cw20Coins, coin)
+		default:
+			cosmosCoins = append(cosmosCoins, coin)
+		}
+	}
+
+	return evmCoins, cw20Coins, cosmosCoins
+}
@@ -11,6 +11,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/xpladev/xpla/x/bank/types"
 )
 
 type msgServer struct {
@@ -126,3 +128,106 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *banktypes.MsgMultiSend)
 
 	return &banktypes.MsgMultiSendResponse{}, nil
 }
+
+type xplaMsgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = xplaMsgServer{}
+
+// NewXplaMsgServerImpl returns an implementation of the xpla bank
+// MsgServer interface for the provided Keeper.
+func NewXplaMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &xplaMsgServer{Keeper: keeper}
+}
+
+// RegisterToken implements types.MsgServer.
+func (k xplaMsgServer) RegisterToken(goCtx context.Context, req *types.MsgRegisterToken) (*types.MsgRegisterTokenResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := k.Keeper.RegisterToken(goCtx, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterTokenResponse{}, nil
+}
+
+// UnregisterToken implements types.MsgServer.
+func (k xplaMsgServer) UnregisterToken(goCtx context.Context, req *types.MsgUnregisterToken) (*types.MsgUnregisterTokenResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := k.Keeper.UnregisterToken(goCtx, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnregisterTokenResponse{}, nil
+}
+
+// RefreshTokenMetadata implements types.MsgServer.
+func (k xplaMsgServer) RefreshTokenMetadata(goCtx context.Context, req *types.MsgRefreshTokenMetadata) (*types.MsgRefreshTokenMetadataResponse, error) {
+	if _, err := k.ak.AddressCodec().StringToBytes(req.Sender); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.Keeper.RefreshTokenMetadata(goCtx, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefreshTokenMetadataResponse{}, nil
+}
+
+// Approve implements types.MsgServer.
+func (k xplaMsgServer) Approve(goCtx context.Context, req *types.MsgApprove) (*types.MsgApproveResponse, error) {
+	owner, err := k.ak.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	spender, err := k.ak.AddressCodec().StringToBytes(req.Spender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid spender address: %s", err)
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.Approve(goCtx, owner, spender, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveResponse{}, nil
+}
+
+// SendFrom implements types.MsgServer.
+func (k xplaMsgServer) SendFrom(goCtx context.Context, req *types.MsgSendFrom) (*types.MsgSendFromResponse, error) {
+	spender, err := k.ak.AddressCodec().StringToBytes(req.Spender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid spender address: %s", err)
+	}
+	owner, err := k.ak.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	to, err := k.ak.AddressCodec().StringToBytes(req.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", req.ToAddress)
+	}
+
+	if err := k.SendCoinsFrom(goCtx, spender, owner, to, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendFromResponse{}, nil
+}
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/xpladev/xpla/x/bank/types"
+)
+
+func (k Keeper) HasRegisteredToken(ctx context.Context, denom string) (bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Has(types.GetRegisteredTokenKey(denom))
+}
+
+func (k Keeper) GetRegisteredToken(ctx context.Context, denom string) (types.RegisteredToken, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetRegisteredTokenKey(denom))
+	if err != nil {
+		return types.RegisteredToken{}, err
+	}
+	if bz == nil {
+		return types.RegisteredToken{}, types.ErrTokenNotRegistered.Wrap(denom)
+	}
+
+	token := types.RegisteredToken{}
+	k.cdc.MustUnmarshal(bz, &token)
+
+	return token, nil
+}
+
+func (k Keeper) SetRegisteredToken(ctx context.Context, token types.RegisteredToken) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&token)
+	return store.Set(types.GetRegisteredTokenKey(token.Denom), bz)
+}
+
+func (k Keeper) DeleteRegisteredToken(ctx context.Context, denom string) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetRegisteredTokenKey(denom))
+}
+
+// GetRegisteredTokens returns all the registered tokens ordered by denom.
+func (k Keeper) GetRegisteredTokens(ctx context.Context) ([]types.RegisteredToken, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.RegisteredTokenKey, storetypes.PrefixEndBytes(types.RegisteredTokenKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	tokens := []types.RegisteredToken{}
+	for ; iterator.Valid(); iterator.Next() {
+		token := types.RegisteredToken{}
+		k.cdc.MustUnmarshal(iterator.Value(), &token)
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+// RegisterToken adds an erc20 or cw20 token to the token registry and caches
+// the metadata of its contract into the bank denom metadata.
+func (k Keeper) RegisterToken(ctx context.Context, denom string) error {
+	if err := types.ValidateTokenDenom(denom); err != nil {
+		return err
+	}
+
+	registered, err := k.HasRegisteredToken(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if registered {
+		return types.ErrTokenAlreadyRegistered.Wrap(denom)
+	}
+
+	if err := k.updateTokenMetadata(ctx, denom); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventTokenRegistered{Denom: denom})
+}
+
+// UnregisterToken removes a token and its denom metadata from the token
+// registry.
+func (k Keeper) UnregisterToken(ctx context.Context, denom string) error {
+	registered, err := k.HasRegisteredToken(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if !registered {
+		return types.ErrTokenNotRegistered.Wrap(denom)
+	}
+
+	if err := k.DeleteRegisteredToken(ctx, denom); err != nil {
+		return err
+	}
+	if err := k.BaseViewKeeper.DenomMetadata.Remove(ctx, denom); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventTokenUnregistered{Denom: denom})
+}
+
+// RefreshTokenMetadata fetches the metadata of a registered token from its
+// contract again. The metadata of a token can be refreshed at most once every
+// TokenMetadataRefreshInterval blocks.
+func (k Keeper) RefreshTokenMetadata(ctx context.Context, denom string) error {
+	token, err := k.GetRegisteredToken(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	nextHeight := token.MetadataHeight + types.TokenMetadataRefreshInterval
+	if height := sdk.UnwrapSDKContext(ctx).BlockHeight(); height < nextHeight {
+		return types.ErrTokenMetadataRefreshed.Wrapf("%s can be refreshed from height %d, current height %d", denom, nextHeight, height)
+	}
+
+	if err := k.updateTokenMetadata(ctx, denom); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventTokenMetadataRefreshed{Denom: denom})
+}
+
+// QueryTokenMetadata queries the metadata of an erc20 or cw20 token from its
+// contract.
+func (k Keeper) QueryTokenMetadata(ctx context.Context, denom string) (*types.TokenMetadata, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tokenType, address := types.ParseDenom(denom)
+	switch tokenType {
+	case types.Erc20:
+		return k.bek.erc20keeper.QueryMetadata(sdkCtx, common.HexToAddress(address))
+	case types.Cw20:
+		contractAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenInfo, err := k.bck.cw20keeper.QueryTokenInfo(sdkCtx, contractAddress)
+		if err != nil {
+			return nil, err
+		}
+		if tokenInfo.Decimals < 0 {
+			return nil, types.ErrInvalidToken.Wrapf("invalid cw20 decimals: %d", tokenInfo.Decimals)
+		}
+
+		return &types.TokenMetadata{
+			Name:     tokenInfo.Name,
+			Symbol:   tokenInfo.Symbol,
+			Decimals: uint32(tokenInfo.Decimals),
+		}, nil
+	default:
+		return nil, types.ErrInvalidToken.Wrapf("not an erc20 or cw20 denom: %s", denom)
+	}
+}
+
+// updateTokenMetadata caches the metadata of the token contract into the bank
+// denom metadata and records the height in the token registry.
+func (k Keeper) updateTokenMetadata(ctx context.Context, denom string) error {
+	tokenMetadata, err := k.QueryTokenMetadata(ctx, denom)
+	if err != nil {
+		return types.ErrInvalidToken.Wrapf("failed to query the metadata of %s: %s", denom, err)
+	}
+
+	metadata := tokenMetadata.BankMetadata(denom)
+	if err := metadata.Validate(); err != nil {
+		return types.ErrInvalidToken.Wrapf("invalid metadata of %s: %s", denom, err)
+	}
+
+	k.SetDenomMetaData(ctx, metadata)
+
+	return k.SetRegisteredToken(ctx, types.NewRegisteredToken(denom, sdk.UnwrapSDKContext(ctx).BlockHeight()))
+}
+
+// GetBalancesWithTokens returns the non-zero balances of an account for the
+// given native and registered token denoms. Unlike GetAllBalances, it calls
+// the token contracts, so it is meant for queries only. Tokens whose contract
+// fails to answer are returned as failed tokens.
+func (k Keeper) GetBalancesWithTokens(ctx context.Context, addr sdk.AccAddress, denoms []string) (sdk.Coins, []types.FailedToken) {
+	balances := sdk.NewCoins()
+	failedTokens := []types.FailedToken{}
+	for _, denom := range denoms {
+		balance, err := k.QueryBalance(ctx, addr, denom)
+		if err != nil {
+			failedTokens = append(failedTokens, types.FailedToken{Denom: denom, Error: err.Error()})
+			continue
+		}
+		if !balance.IsPositive() {
+			continue
+		}
+
+		balances = balances.Add(balance)
+	}
+
+	return balances, failedTokens
+}
+
+// InitTokenRegistryGenesis initializes the token registry from the xpla bank
+// genesis state.
+func (k Keeper) InitTokenRegistryGenesis(ctx context.Context, genState *types.GenesisState) {
+	for _, token := range genState.RegisteredTokens {
+		if err := k.SetRegisteredToken(ctx, token); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportTokenRegistryGenesis returns the xpla bank genesis state.
+func (k Keeper) ExportTokenRegistryGenesis(ctx context.Context) *types.GenesisState {
+	tokens, err := k.GetRegisteredTokens(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(tokens)
+}
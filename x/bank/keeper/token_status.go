@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/xpladev/xpla/x/bank/types"
+)
+
+// CheckContractToken calls the view methods of an erc20 or cw20 token on the
+// contract and returns the bank denom of the contract with the results. A hex
+// address is checked as an erc20 contract and a bech32 address as a cw20
+// contract.
+func (k Keeper) CheckContractToken(goCtx context.Context, contractAddress string) (string, []types.ContractTokenCheck, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	probe := k.ak.GetModuleAddress(banktypes.ModuleName)
+
+	if strings.HasPrefix(contractAddress, "0x") {
+		if !common.IsHexAddress(contractAddress) {
+			return "", nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid hex address: %s", contractAddress)
+		}
+
+		address := common.HexToAddress(contractAddress)
+		erc20keeper := k.bek.erc20keeper
+
+		_, totalSupplyErr := erc20keeper.QueryTotalSupply(ctx, address)
+		_, balanceErr := erc20keeper.QueryBalanceOf(ctx, address, probe)
+		_, allowanceErr := erc20keeper.QueryAllowance(ctx, address, probe, probe)
+		_, nameErr := erc20keeper.queryContract(ctx, MetadataABI, address, types.Name)
+		_, symbolErr := erc20keeper.queryContract(ctx, MetadataABI, address, types.Symbol)
+		_, decimalsErr := erc20keeper.queryContract(ctx, MetadataABI, address, types.Decimals)
+
+		checks := []types.ContractTokenCheck{
+			types.NewContractTokenCheck(types.GetErc20Method(types.TotalSupply), true, totalSupplyErr),
+			types.NewContractTokenCheck(types.GetErc20Method(types.BalanceOf), true, balanceErr),
+			types.NewContractTokenCheck(types.GetErc20Method(types.Allowance), true, allowanceErr),
+			types.NewContractTokenCheck(types.GetErc20Method(types.Name), false, nameErr),
+			types.NewContractTokenCheck(types.GetErc20Method(types.Symbol), false, symbolErr),
+			types.NewContractTokenCheck(types.GetErc20Method(types.Decimals), false, decimalsErr),
+		}
+
+		return types.NewErc20Coin(address.Hex(), sdkmath.ZeroInt()).Denom, checks, nil
+	}
+
+	address, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return "", nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address: %s", err)
+	}
+
+	cw20keeper := k.bck.cw20keeper
+
+	_, tokenInfoErr := cw20keeper.QueryTokenInfo(ctx, address)
+	_, balanceErr := cw20keeper.QueryBalance(ctx, address, &types.QueryMsg_Balance{Address: probe.String()})
+	_, allowanceErr := cw20keeper.QueryAllowance(ctx, address, &types.QueryMsg_Allowance{Owner: probe.String(), Spender: probe.String()})
+
+	checks := []types.ContractTokenCheck{
+		types.NewContractTokenCheck("token_info", true, tokenInfoErr),
+		types.NewContractTokenCheck("balance", true, balanceErr),
+		// only with the allowance extension of cw20
+		types.NewContractTokenCheck("allowance", false, allowanceErr),
+	}
+
+	return types.NewCw20Coin(address.String(), sdkmath.ZeroInt()).Denom, checks, nil
+}
@@ -1,9 +1,16 @@
 package bank
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/bank/exported"
@@ -11,6 +18,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	authkeeper "github.com/xpladev/xpla/x/auth/keeper"
 	"github.com/xpladev/xpla/x/bank/keeper"
+	"github.com/xpladev/xpla/x/bank/types"
 )
 
 type AppModule struct {
@@ -34,6 +42,8 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, accountKeeper authkeepe
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	banktypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewXplaMsgServerImpl(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
 
 	m := bankkeeper.NewMigrator(am.keeper.BaseKeeper, am.legacySubspace)
 	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
@@ -48,3 +58,126 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 		panic(fmt.Sprintf("failed to migrate x/bank from version 3 to 4: %v", err))
 	}
 }
+
+// RegisterLegacyAminoCodec registers the bank module's types on the LegacyAmino codec.
+func (am AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	am.AppModule.RegisterLegacyAminoCodec(cdc)
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers interfaces and implementations of the bank module.
+func (am AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	am.AppModule.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the bank module.
+func (am AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	am.AppModule.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// ValidateGenesis performs genesis state validation for the bank module.
+func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	bankGenesis, xplaGenesis, err := splitGenesis(bz)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", banktypes.ModuleName, err)
+	}
+
+	if err := am.AppModule.ValidateGenesis(cdc, config, bankGenesis); err != nil {
+		return err
+	}
+
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(xplaGenesis, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", banktypes.ModuleName, err)
+	}
+
+	return data.Validate()
+}
+
+// InitGenesis performs genesis initialization for the bank module.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
+	bankGenesis, xplaGenesis, err := splitGenesis(data)
+	if err != nil {
+		panic(err)
+	}
+
+	am.AppModule.InitGenesis(ctx, cdc, bankGenesis)
+
+	var genesisState types.GenesisState
+	cdc.MustUnmarshalJSON(xplaGenesis, &genesisState)
+	am.keeper.InitTokenRegistryGenesis(ctx, &genesisState)
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the bank
+// module, including the xpla genesis state. The xpla fields are left out while
+// the token registry is empty, so that the genesis state stays readable as the
+// cosmos-sdk bank genesis state by the genesis tools.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	bankGenesis := am.AppModule.ExportGenesis(ctx, cdc)
+
+	genesisState := am.keeper.ExportTokenRegistryGenesis(ctx)
+	if len(genesisState.RegisteredTokens) == 0 {
+		return bankGenesis
+	}
+
+	bz, err := mergeGenesis(bankGenesis, cdc.MustMarshalJSON(genesisState))
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// xplaGenesisFields are the json fields of the xpla bank genesis state, which
+// are stored next to the fields of the cosmos-sdk bank genesis state.
+var xplaGenesisFields = []string{"registered_tokens"}
+
+// splitGenesis splits the bank genesis state into the cosmos-sdk bank genesis
+// state and the xpla bank genesis state.
+func splitGenesis(bz json.RawMessage) (bankGenesis, xplaGenesis json.RawMessage, err error) {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, nil, err
+	}
+
+	xplaFields := make(map[string]json.RawMessage)
+	for _, field := range xplaGenesisFields {
+		if value, ok := fields[field]; ok {
+			xplaFields[field] = value
+			delete(fields, field)
+		}
+	}
+
+	if bankGenesis, err = json.Marshal(fields); err != nil {
+		return nil, nil, err
+	}
+	if xplaGenesis, err = json.Marshal(xplaFields); err != nil {
+		return nil, nil, err
+	}
+
+	return bankGenesis, xplaGenesis, nil
+}
+
+// mergeGenesis merges the cosmos-sdk bank genesis state and the xpla bank
+// genesis state into the bank genesis state.
+func mergeGenesis(bankGenesis, xplaGenesis json.RawMessage) (json.RawMessage, error) {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(bankGenesis, &fields); err != nil {
+		return nil, err
+	}
+
+	xplaFields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(xplaGenesis, &xplaFields); err != nil {
+		return nil, err
+	}
+
+	for field, value := range xplaFields {
+		fields[field] = value
+	}
+
+	return json.Marshal(fields)
+}
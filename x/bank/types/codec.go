@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the xpla extension of the x/bank concrete
+// types on the provided LegacyAmino codec. These types are used for Amino JSON
+// serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterToken{}, "xpladev/x/bank/MsgRegisterToken")
+	legacy.RegisterAminoMsg(cdc, &MsgUnregisterToken{}, "xpladev/x/bank/MsgUnregisterToken")
+	legacy.RegisterAminoMsg(cdc, &MsgRefreshTokenMetadata{}, "xpladev/x/bank/MsgRefreshTokenMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgApprove{}, "xpladev/x/bank/MsgApprove")
+	legacy.RegisterAminoMsg(cdc, &MsgSendFrom{}, "xpladev/x/bank/MsgSendFrom")
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterToken{},
+		&MsgUnregisterToken{},
+		&MsgRefreshTokenMetadata{},
+		&MsgApprove{},
+		&MsgSendFrom{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -1,11 +1,21 @@
 package types
 
+import "github.com/ethereum/go-ethereum/common"
+
+// Erc20DeadAddress receives the erc20 tokens which cannot be burned by the
+// token contract itself
+var Erc20DeadAddress = common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+
 type MethodErc20 string
 
 const (
 	Allowance    MethodErc20 = "allowance"
 	Approve      MethodErc20 = "approve"
 	BalanceOf    MethodErc20 = "balanceOf"
+	Burn         MethodErc20 = "burn"
+	Decimals     MethodErc20 = "decimals"
+	Name         MethodErc20 = "name"
+	Symbol       MethodErc20 = "symbol"
 	TotalSupply  MethodErc20 = "totalSupply"
 	Transfer     MethodErc20 = "transfer"
 	TransferFrom MethodErc20 = "transferFrom"
@@ -9,4 +9,19 @@ var (
 	ErrErc20Transfer    = sdkerrors.Register(banktypes.ModuleName, 1001, "fail to transfer erc20")
 	ErrErc20Balance     = sdkerrors.Register(banktypes.ModuleName, 1002, "fail to query balance erc20")
 	ErrErc20TotalSupply = sdkerrors.Register(banktypes.ModuleName, 1003, "fail to query total supply erc20")
+
+	ErrInvalidToken           = sdkerrors.Register(banktypes.ModuleName, 1004, "invalid token")
+	ErrTokenAlreadyRegistered = sdkerrors.Register(banktypes.ModuleName, 1005, "token already registered")
+	ErrTokenNotRegistered     = sdkerrors.Register(banktypes.ModuleName, 1006, "token not registered")
+
+	ErrErc20Metadata     = sdkerrors.Register(banktypes.ModuleName, 1007, "fail to query metadata erc20")
+	ErrErc20Approve      = sdkerrors.Register(banktypes.ModuleName, 1008, "fail to approve erc20")
+	ErrErc20Allowance    = sdkerrors.Register(banktypes.ModuleName, 1009, "fail to query allowance erc20")
+	ErrErc20TransferFrom = sdkerrors.Register(banktypes.ModuleName, 1010, "fail to transfer from erc20")
+
+	ErrCw20Balance   = sdkerrors.Register(banktypes.ModuleName, 1011, "fail to query balance cw20")
+	ErrCw20TokenInfo = sdkerrors.Register(banktypes.ModuleName, 1012, "fail to query token info cw20")
+	ErrCw20Allowance = sdkerrors.Register(banktypes.ModuleName, 1013, "fail to query allowance cw20")
+
+	ErrTokenMetadataRefreshed = sdkerrors.Register(banktypes.ModuleName, 1014, "token metadata refreshed too recently")
 )
@@ -0,0 +1,958 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: xpla/bank/v1beta1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventTokenRegistered is emitted when a token is added to the token registry
+type EventTokenRegistered struct {
+	// denom is the bank denom of the token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventTokenRegistered) Reset()         { *m = EventTokenRegistered{} }
+func (m *EventTokenRegistered) String() string { return proto.CompactTextString(m) }
+func (*EventTokenRegistered) ProtoMessage()    {}
+func (*EventTokenRegistered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1e1217dc2116055, []int{0}
+}
+func (m *EventTokenRegistered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTokenRegistered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTokenRegistered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTokenRegistered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTokenRegistered.Merge(m, src)
+}
+func (m *EventTokenRegistered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTokenRegistered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTokenRegistered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTokenRegistered proto.InternalMessageInfo
+
+func (m *EventTokenRegistered) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EventTokenUnregistered is emitted when a token is removed from the token
+// registry
+type EventTokenUnregistered struct {
+	// denom is the bank denom of the token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventTokenUnregistered) Reset()         { *m = EventTokenUnregistered{} }
+func (m *EventTokenUnregistered) String() string { return proto.CompactTextString(m) }
+func (*EventTokenUnregistered) ProtoMessage()    {}
+func (*EventTokenUnregistered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1e1217dc2116055, []int{1}
+}
+func (m *EventTokenUnregistered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTokenUnregistered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTokenUnregistered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTokenUnregistered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTokenUnregistered.Merge(m, src)
+}
+func (m *EventTokenUnregistered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTokenUnregistered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTokenUnregistered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTokenUnregistered proto.InternalMessageInfo
+
+func (m *EventTokenUnregistered) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EventTokenMetadataRefreshed is emitted when the metadata of a registered
+// token is fetched again from its contract
+type EventTokenMetadataRefreshed struct {
+	// denom is the bank denom of the token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventTokenMetadataRefreshed) Reset()         { *m = EventTokenMetadataRefreshed{} }
+func (m *EventTokenMetadataRefreshed) String() string { return proto.CompactTextString(m) }
+func (*EventTokenMetadataRefreshed) ProtoMessage()    {}
+func (*EventTokenMetadataRefreshed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1e1217dc2116055, []int{2}
+}
+func (m *EventTokenMetadataRefreshed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTokenMetadataRefreshed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTokenMetadataRefreshed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTokenMetadataRefreshed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTokenMetadataRefreshed.Merge(m, src)
+}
+func (m *EventTokenMetadataRefreshed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTokenMetadataRefreshed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTokenMetadataRefreshed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTokenMetadataRefreshed proto.InternalMessageInfo
+
+func (m *EventTokenMetadataRefreshed) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EventErc20TransferredToDeadAddress is emitted when erc20 tokens are burned
+// by a transfer to the dead address, because their contract does not
+// implement burn. The total supply of the token does not decrease.
+type EventErc20TransferredToDeadAddress struct {
+	// sender is the address of the account whose tokens were burned
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount is the amount transferred to the dead address
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// dead_address is the hex address which received the tokens
+	DeadAddress string `protobuf:"bytes,3,opt,name=dead_address,json=deadAddress,proto3" json:"dead_address,omitempty"`
+}
+
+func (m *EventErc20TransferredToDeadAddress) Reset()         { *m = EventErc20TransferredToDeadAddress{} }
+func (m *EventErc20TransferredToDeadAddress) String() string { return proto.CompactTextString(m) }
+func (*EventErc20TransferredToDeadAddress) ProtoMessage()    {}
+func (*EventErc20TransferredToDeadAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1e1217dc2116055, []int{3}
+}
+func (m *EventErc20TransferredToDeadAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventErc20TransferredToDeadAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventErc20TransferredToDeadAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventErc20TransferredToDeadAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventErc20TransferredToDeadAddress.Merge(m, src)
+}
+func (m *EventErc20TransferredToDeadAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventErc20TransferredToDeadAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventErc20TransferredToDeadAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventErc20TransferredToDeadAddress proto.InternalMessageInfo
+
+func (m *EventErc20TransferredToDeadAddress) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventErc20TransferredToDeadAddress) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EventErc20TransferredToDeadAddress) GetDeadAddress() string {
+	if m != nil {
+		return m.DeadAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventTokenRegistered)(nil), "xpla.bank.v1beta1.EventTokenRegistered")
+	proto.RegisterType((*EventTokenUnregistered)(nil), "xpla.bank.v1beta1.EventTokenUnregistered")
+	proto.RegisterType((*EventTokenMetadataRefreshed)(nil), "xpla.bank.v1beta1.EventTokenMetadataRefreshed")
+	proto.RegisterType((*EventErc20TransferredToDeadAddress)(nil), "xpla.bank.v1beta1.EventErc20TransferredToDeadAddress")
+}
+
+func init() { proto.RegisterFile("xpla/bank/v1beta1/events.proto", fileDescriptor_b1e1217dc2116055) }
+
+var fileDescriptor_b1e1217dc2116055 = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xbf, 0x4e, 0xeb, 0x30,
+	0x14, 0xc6, 0x93, 0xfb, 0xa7, 0xd2, 0x75, 0xef, 0x42, 0x14, 0xa1, 0xb4, 0x48, 0xa6, 0x54, 0x0c,
+	0x1d, 0x20, 0xee, 0x9f, 0x81, 0x0d, 0x89, 0x42, 0x47, 0x96, 0x50, 0x16, 0x96, 0xca, 0x89, 0x4f,
+	0xd3, 0xa8, 0xc4, 0xae, 0x6c, 0xb7, 0x2a, 0x6f, 0xc1, 0xb3, 0x20, 0x1e, 0xa2, 0x63, 0xc5, 0xc4,
+	0x84, 0x50, 0xfb, 0x22, 0x28, 0xb1, 0xa1, 0x13, 0x62, 0x4a, 0xce, 0xf9, 0x7e, 0x9f, 0x7d, 0x7c,
+	0x3e, 0x84, 0x97, 0xb3, 0x7b, 0x4a, 0x62, 0xca, 0xa7, 0x64, 0xd1, 0x89, 0x41, 0xd3, 0x0e, 0x81,
+	0x05, 0x70, 0xad, 0xc2, 0x99, 0x14, 0x5a, 0x78, 0x7b, 0x85, 0x1e, 0x16, 0x7a, 0x68, 0xf5, 0x3a,
+	0x4e, 0x84, 0xca, 0x85, 0x22, 0x31, 0x55, 0xf0, 0x65, 0x4a, 0x44, 0xc6, 0x8d, 0xa5, 0x5e, 0x33,
+	0xfa, 0xa8, 0xac, 0x88, 0x29, 0xac, 0xe4, 0xa7, 0x22, 0x15, 0xa6, 0x5f, 0xfc, 0x99, 0x6e, 0xf3,
+	0x04, 0xf9, 0x83, 0xe2, 0xce, 0xa1, 0x98, 0x02, 0x8f, 0x20, 0xcd, 0x94, 0x06, 0x09, 0xcc, 0xf3,
+	0xd1, 0x5f, 0x06, 0x5c, 0xe4, 0x81, 0xdb, 0x70, 0x5b, 0xff, 0x22, 0x53, 0x34, 0x43, 0xb4, 0xbf,
+	0xa3, 0x6f, 0xb9, 0xfc, 0x89, 0xef, 0xa1, 0x83, 0x1d, 0x7f, 0x0d, 0x9a, 0x32, 0xaa, 0x69, 0x04,
+	0x63, 0x09, 0x6a, 0xf2, 0xad, 0xe9, 0xc9, 0x45, 0xcd, 0xd2, 0x35, 0x90, 0x49, 0xb7, 0x3d, 0x94,
+	0x94, 0xab, 0x31, 0x48, 0x09, 0x6c, 0x28, 0xae, 0x80, 0xb2, 0x0b, 0xc6, 0x24, 0x28, 0xe5, 0xb5,
+	0x51, 0x45, 0x01, 0x67, 0x20, 0x8d, 0xbb, 0x1f, 0xbc, 0x3c, 0x9f, 0xfa, 0xf6, 0xc5, 0x96, 0xb9,
+	0xd1, 0x32, 0xe3, 0x69, 0x64, 0x39, 0xef, 0x0c, 0x55, 0x68, 0x2e, 0xe6, 0x5c, 0x07, 0xbf, 0x1a,
+	0x6e, 0xab, 0xda, 0xad, 0x85, 0x16, 0x2f, 0xb6, 0xf9, 0xb9, 0xe2, 0xf0, 0x52, 0x64, 0xbc, 0xff,
+	0x67, 0xf5, 0x76, 0xe8, 0x44, 0x16, 0xf7, 0x8e, 0xd0, 0x7f, 0x06, 0x94, 0x8d, 0xa8, 0x39, 0x36,
+	0xf8, 0x5d, 0x8e, 0x5b, 0x65, 0xbb, 0x69, 0xfa, 0xe7, 0xab, 0x0d, 0x76, 0xd7, 0x1b, 0xec, 0xbe,
+	0x6f, 0xb0, 0xfb, 0xb8, 0xc5, 0xce, 0x7a, 0x8b, 0x9d, 0xd7, 0x2d, 0x76, 0xee, 0x8e, 0xd3, 0x4c,
+	0x4f, 0xe6, 0x71, 0x98, 0x88, 0x9c, 0x14, 0x81, 0x32, 0x58, 0x94, 0x5f, 0xb2, 0x34, 0xd1, 0xeb,
+	0x87, 0x19, 0xa8, 0xb8, 0x52, 0xc6, 0xd1, 0xfb, 0x08, 0x00, 0x00, 0xff, 0xff, 0x09, 0xee, 0xdc,
+	0x53, 0x14, 0x02, 0x00, 0x00,
+}
+
+func (m *EventTokenRegistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTokenRegistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTokenRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTokenUnregistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTokenUnregistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTokenUnregistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTokenMetadataRefreshed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTokenMetadataRefreshed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTokenMetadataRefreshed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventErc20TransferredToDeadAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventErc20TransferredToDeadAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventErc20TransferredToDeadAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeadAddress) > 0 {
+		i -= len(m.DeadAddress)
+		copy(dAtA[i:], m.DeadAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DeadAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventTokenRegistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTokenUnregistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTokenMetadataRefreshed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventErc20TransferredToDeadAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.DeadAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventTokenRegistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTokenRegistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTokenRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTokenUnregistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTokenUnregistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTokenUnregistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTokenMetadataRefreshed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTokenMetadataRefreshed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTokenMetadataRefreshed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventErc20TransferredToDeadAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventErc20TransferredToDeadAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventErc20TransferredToDeadAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
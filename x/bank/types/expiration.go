@@ -0,0 +1,24 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsExpired reports whether the cw20 expiration is reached at the current
+// block, following the cw20-base contract.
+func (e Expiration) IsExpired(ctx sdk.Context) bool {
+	switch {
+	case e.AtHeight != nil:
+		return ctx.BlockHeight() >= int64(*e.AtHeight)
+	case e.AtTime != nil:
+		nanos, err := strconv.ParseUint(string(*e.AtTime), 10, 64)
+		if err != nil {
+			return false
+		}
+		return uint64(ctx.BlockTime().UnixNano()) >= nanos
+	default:
+		return false
+	}
+}
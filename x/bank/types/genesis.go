@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+)
+
+func NewGenesisState(registeredTokens []RegisteredToken) *GenesisState {
+	return &GenesisState{
+		RegisteredTokens: registeredTokens,
+	}
+}
+
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]RegisteredToken{})
+}
+
+// Validate performs basic validation of the xpla bank genesis data returning
+// an error for any failed validation criteria.
+func (gs GenesisState) Validate() error {
+	denoms := make(map[string]bool)
+	for _, token := range gs.RegisteredTokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+
+		if denoms[token.Denom] {
+			return fmt.Errorf("duplicate registered token: %s", token.Denom)
+		}
+		denoms[token.Denom] = true
+	}
+
+	return nil
+}
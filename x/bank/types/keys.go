@@ -0,0 +1,12 @@
+package types
+
+var (
+	// RegisteredTokenKey is the prefix of the token registry. The registry is
+	// kept in the bank module's store, so the prefix must not collide with
+	// the collection prefixes of the cosmos-sdk bank keeper.
+	RegisteredTokenKey = []byte{0xa0}
+)
+
+func GetRegisteredTokenKey(denom string) []byte {
+	return append(RegisteredTokenKey, []byte(denom)...)
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterToken{}
+	_ sdk.Msg = &MsgUnregisterToken{}
+	_ sdk.Msg = &MsgRefreshTokenMetadata{}
+	_ sdk.Msg = &MsgApprove{}
+	_ sdk.Msg = &MsgSendFrom{}
+)
+
+func NewMsgRegisterToken(authority, denom string) *MsgRegisterToken {
+	return &MsgRegisterToken{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+func (msg MsgRegisterToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateTokenDenom(msg.Denom)
+}
+
+func NewMsgUnregisterToken(authority, denom string) *MsgUnregisterToken {
+	return &MsgUnregisterToken{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+func (msg MsgUnregisterToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateTokenDenom(msg.Denom)
+}
+
+func NewMsgRefreshTokenMetadata(sender, denom string) *MsgRefreshTokenMetadata {
+	return &MsgRefreshTokenMetadata{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (msg MsgRefreshTokenMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	return ValidateTokenDenom(msg.Denom)
+}
+
+func NewMsgApprove(owner, spender sdk.AccAddress, amount sdk.Coins) *MsgApprove {
+	return &MsgApprove{
+		Owner:   owner.String(),
+		Spender: spender.String(),
+		Amount:  amount,
+	}
+}
+
+func (msg MsgApprove) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Spender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid spender address: %s", err)
+	}
+
+	if msg.Amount.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrap("empty allowances")
+	}
+
+	// zero amounts are allowed to revoke allowances
+	for i, coin := range msg.Amount {
+		if err := coin.Validate(); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+		}
+		if i > 0 && msg.Amount[i-1].Denom >= coin.Denom {
+			return sdkerrors.ErrInvalidCoins.Wrapf("allowances are not sorted or have duplicates: %s", msg.Amount)
+		}
+		if err := ValidateTokenDenom(coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewMsgSendFrom(spender, owner, toAddr sdk.AccAddress, amount sdk.Coins) *MsgSendFrom {
+	return &MsgSendFrom{
+		Spender:   spender.String(),
+		Owner:     owner.String(),
+		ToAddress: toAddr.String(),
+		Amount:    amount,
+	}
+}
+
+func (msg MsgSendFrom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Spender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid spender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	for _, coin := range msg.Amount {
+		if err := ValidateTokenDenom(coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
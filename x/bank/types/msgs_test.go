@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/xpladev/xpla/x/bank/types"
+)
+
+func TestMsgApproveValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	spender := sdk.AccAddress([]byte("spender_____________"))
+	erc20Denom := "xerc20:0xA2dC463DD29be4C8a28dB0C09D89b0AA89Fc9546"
+	cw20Denom := "xcw20:" + testCw20Address
+
+	tests := []struct {
+		name   string
+		amount sdk.Coins
+		err    error
+	}{
+		{"valid", sdk.Coins{sdk.NewCoin(cw20Denom, sdkmath.NewInt(1)), sdk.NewCoin(erc20Denom, sdkmath.NewInt(2))}, nil},
+		{"zero revokes", sdk.Coins{sdk.NewCoin(cw20Denom, sdkmath.ZeroInt())}, nil},
+		{"empty", sdk.Coins{}, sdkerrors.ErrInvalidCoins},
+		{"unsorted", sdk.Coins{sdk.NewCoin(erc20Denom, sdkmath.NewInt(2)), sdk.NewCoin(cw20Denom, sdkmath.NewInt(1))}, sdkerrors.ErrInvalidCoins},
+		{"duplicated", sdk.Coins{sdk.NewCoin(cw20Denom, sdkmath.NewInt(1)), sdk.NewCoin(cw20Denom, sdkmath.NewInt(1))}, sdkerrors.ErrInvalidCoins},
+		{"native", sdk.Coins{sdk.NewCoin("axpla", sdkmath.NewInt(1))}, types.ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.NewMsgApprove(owner, spender, tt.amount).ValidateBasic()
+			if tt.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestMsgSendFromValidateBasic(t *testing.T) {
+	spender := sdk.AccAddress([]byte("spender_____________"))
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+	cw20Denom := "xcw20:" + testCw20Address
+
+	tests := []struct {
+		name   string
+		amount sdk.Coins
+		err    error
+	}{
+		{"valid", sdk.NewCoins(sdk.NewCoin(cw20Denom, sdkmath.NewInt(1))), nil},
+		{"zero", sdk.Coins{sdk.NewCoin(cw20Denom, sdkmath.ZeroInt())}, sdkerrors.ErrInvalidCoins},
+		{"empty", sdk.Coins{}, sdkerrors.ErrInvalidCoins},
+		{"native", sdk.NewCoins(sdk.NewCoin("axpla", sdkmath.NewInt(1))), types.ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.NewMsgSendFrom(spender, owner, to, tt.amount).ValidateBasic()
+			if tt.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestExpirationIsExpired(t *testing.T) {
+	blockTime := time.Unix(1700000000, 0)
+	ctx := sdk.Context{}.WithBlockHeight(100).WithBlockTime(blockTime)
+
+	atHeight := func(height int) types.Expiration {
+		h := types.Expiration_AtHeight(height)
+		return types.Expiration{AtHeight: &h}
+	}
+	atTime := func(t time.Time) types.Expiration {
+		ts := types.Expiration_AtTime(sdkmath.NewInt(t.UnixNano()).String())
+		return types.Expiration{AtTime: &ts}
+	}
+
+	require.False(t, types.Expiration{Never: &types.Expiration_Never{}}.IsExpired(ctx))
+	require.False(t, atHeight(101).IsExpired(ctx))
+	require.True(t, atHeight(100).IsExpired(ctx))
+	require.False(t, atTime(blockTime.Add(time.Second)).IsExpired(ctx))
+	require.True(t, atTime(blockTime).IsExpired(ctx))
+}
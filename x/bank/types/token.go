@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ContractQueryGasLimit is the gas limit of a single erc20 or cw20 contract
+// query made by the bank module, so that a broken or malicious token contract
+// cannot use up the gas of the whole transaction or query.
+const ContractQueryGasLimit uint64 = 3_000_000
+
+// TokenMetadataRefreshInterval is the number of blocks that must pass since the
+// metadata of a registered token was last fetched before anyone can refresh it
+// again, so that the contract queries of a refresh cannot be spammed.
+const TokenMetadataRefreshInterval int64 = 14_400
+
+func NewRegisteredToken(denom string, metadataHeight int64) RegisteredToken {
+	return RegisteredToken{
+		Denom:          denom,
+		MetadataHeight: metadataHeight,
+	}
+}
+
+func (t RegisteredToken) Validate() error {
+	if t.MetadataHeight < 0 {
+		return fmt.Errorf("metadata height cannot be negative")
+	}
+
+	return ValidateTokenDenom(t.Denom)
+}
+
+// ValidateTokenDenom returns an error if the denom is not the denom of an erc20
+// or cw20 token. The contract address must be in its canonical form, the
+// checksummed hex address for erc20 and the bech32 address for cw20, so that
+// a token is registered only once.
+func ValidateTokenDenom(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return ErrInvalidToken.Wrap(err.Error())
+	}
+
+	tokenType, address := ParseDenom(denom)
+	switch tokenType {
+	case Erc20:
+		if !common.IsHexAddress(address) || common.HexToAddress(address).Hex() != address {
+			return ErrInvalidToken.Wrapf("erc20 contract address must be a checksummed hex address: %s", address)
+		}
+	case Cw20:
+		contractAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil || contractAddress.String() != address {
+			return ErrInvalidToken.Wrapf("cw20 contract address must be a bech32 address: %s", address)
+		}
+	default:
+		return ErrInvalidToken.Wrapf("not an erc20 or cw20 denom: %s", denom)
+	}
+
+	return nil
+}
+
+// TokenMetadata defines the metadata supplied by an erc20 or cw20 token
+// contract.
+type TokenMetadata struct {
+	Name     string
+	Symbol   string
+	Decimals uint32
+}
+
+// BankMetadata returns the bank denom metadata of the token denom. The display
+// unit is the lower-cased symbol scaled by the decimals. If the symbol is not
+// a valid denom, the base denom is displayed instead.
+func (m TokenMetadata) BankMetadata(denom string) banktypes.Metadata {
+	tokenType, address := ParseDenom(denom)
+	standard := "cw20"
+	if tokenType == Erc20 {
+		standard = "erc20"
+	}
+
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("%s token of the contract %s", standard, address),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+		},
+		Base:    denom,
+		Display: denom,
+		Name:    m.Name,
+		Symbol:  m.Symbol,
+	}
+
+	display := strings.ToLower(m.Symbol)
+	if m.Decimals > 0 && display != denom && sdk.ValidateDenom(display) == nil {
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{Denom: display, Exponent: m.Decimals})
+		metadata.Display = display
+	}
+
+	return metadata
+}
+
+func NewContractTokenCheck(method string, required bool, err error) ContractTokenCheck {
+	check := ContractTokenCheck{
+		Method:   method,
+		Required: required,
+	}
+	if err != nil {
+		check.Error = err.Error()
+	}
+
+	return check
+}
+
+// IsCompliant reports whether all the required checks succeeded.
+func IsCompliant(checks []ContractTokenCheck) bool {
+	for _, check := range checks {
+		if check.Required && check.Error != "" {
+			return false
+		}
+	}
+
+	return true
+}
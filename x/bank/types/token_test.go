@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/xpladev/xpla/x/bank/types"
+)
+
+const testCw20Address = "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5z5tpwxqergd3c8g7rusqqlvp8l"
+
+func TestValidateTokenDenom(t *testing.T) {
+	tests := []struct {
+		denom   string
+		isValid bool
+	}{
+		{"xerc20:0xA2dC463DD29be4C8a28dB0C09D89b0AA89Fc9546", true},
+		{"xerc20:0xa2dc463dd29be4c8a28db0c09d89b0aa89fc9546", false},
+		{"xerc20:A2dC463DD29be4C8a28dB0C09D89b0AA89Fc9546", false},
+		{"xerc20:0x1234", false},
+		{"xcw20:" + testCw20Address, true},
+		{"xcw20:" + strings.ToUpper(testCw20Address), false},
+		{"xcw20:cosmos1invalid", false},
+		{"axpla", false},
+		{"ibc/8E27BA2D5493AF5636760E354E46004562C46AB7EC0CC4C1CA14E9E20E2545B5", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.denom, func(t *testing.T) {
+			err := types.ValidateTokenDenom(tt.denom)
+			if tt.isValid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidToken)
+			}
+		})
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	erc20Token := types.NewRegisteredToken("xerc20:0xA2dC463DD29be4C8a28dB0C09D89b0AA89Fc9546", 0)
+	cw20Token := types.NewRegisteredToken("xcw20:"+testCw20Address, 0)
+
+	require.NoError(t, types.DefaultGenesisState().Validate())
+	require.NoError(t, types.NewGenesisState([]types.RegisteredToken{erc20Token, cw20Token}).Validate())
+	require.Error(t, types.NewGenesisState([]types.RegisteredToken{erc20Token, erc20Token}).Validate())
+	require.Error(t, types.NewGenesisState([]types.RegisteredToken{types.NewRegisteredToken("axpla", 0)}).Validate())
+}
+
+func TestTokenMetadataBankMetadata(t *testing.T) {
+	denom := "xcw20:" + testCw20Address
+
+	tests := []struct {
+		name     string
+		metadata types.TokenMetadata
+		display  string
+		exponent uint32
+	}{
+		{"with decimals", types.TokenMetadata{Name: "Token", Symbol: "TKN", Decimals: 6}, "tkn", 6},
+		{"without decimals", types.TokenMetadata{Name: "Token", Symbol: "TKN", Decimals: 0}, denom, 0},
+		{"invalid symbol", types.TokenMetadata{Name: "Token", Symbol: "T", Decimals: 6}, denom, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata := tt.metadata.BankMetadata(denom)
+			require.NoError(t, metadata.Validate())
+			require.Equal(t, denom, metadata.Base)
+			require.Equal(t, tt.display, metadata.Display)
+			require.Equal(t, tt.metadata.Name, metadata.Name)
+			require.Equal(t, tt.metadata.Symbol, metadata.Symbol)
+			require.Equal(t, tt.exponent, metadata.DenomUnits[len(metadata.DenomUnits)-1].Exponent)
+		})
+	}
+}
+
+func TestIsCompliant(t *testing.T) {
+	checks := []types.ContractTokenCheck{
+		types.NewContractTokenCheck("token_info", true, nil),
+		types.NewContractTokenCheck("allowance", false, types.ErrInvalidToken),
+	}
+	require.Empty(t, checks[0].Error)
+	require.NotEmpty(t, checks[1].Error)
+	require.True(t, types.IsCompliant(checks))
+
+	checks = append(checks, types.NewContractTokenCheck("balance", true, types.ErrInvalidToken))
+	require.False(t, types.IsCompliant(checks))
+}
@@ -0,0 +1,11 @@
+package burn
+
+import (
+	"context"
+
+	"github.com/xpladev/xpla/x/burn/keeper"
+)
+
+func EndBlocker(ctx context.Context, k keeper.Keeper) error {
+	return k.ExecuteDueBurnSchedules(ctx)
+}
@@ -10,10 +10,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 		Query: &autocliv1.ServiceCommandDescriptor{
 			Service: "xpla.burn.v1beta1.Query",
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current burn parameters",
+				},
 				{
 					RpcMethod: "OngoingProposals",
 					Use:       "ongoing-proposals",
-					Short:     "Query all ongoing burn proposals",
+					Short:     "Query ongoing burn proposals, optionally filtered by proposer and denom",
+					Example:   "$ xplad query burn ongoing-proposals --proposer xpla1... --denom axpla",
 				},
 				{
 					RpcMethod:      "OngoingProposal",
@@ -21,6 +27,54 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query a specific ongoing burn proposal by ID",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
 				},
+				{
+					RpcMethod: "TotalBurned",
+					Use:       "total-burned",
+					Short:     "Query the cumulative burned supply per denom",
+				},
+				{
+					RpcMethod: "BurnHistory",
+					Use:       "burn-history",
+					Short:     "Query the burn history of executed burn proposals",
+				},
+				{
+					RpcMethod: "BurnSchedules",
+					Use:       "burn-schedules",
+					Short:     "Query the active burn schedules",
+				},
+				{
+					RpcMethod: "BurnScheduleHistory",
+					Use:       "burn-schedule-history",
+					Short:     "Query the burn history of executed burn schedules",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "xpla.burn.v1beta1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Burn",
+					Skip:      true, // only executable by governance
+				},
+				{
+					RpcMethod:      "BurnOwn",
+					Use:            "burn-own [amount]",
+					Short:          "Burn coins from your own account",
+					Example:        "$ xplad tx burn burn-own 100axpla --from mykey",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod: "CreateBurnSchedule",
+					Skip:      true, // only executable by governance
+				},
+				{
+					RpcMethod: "CancelBurnSchedule",
+					Skip:      true, // only executable by governance
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // only executable by governance
+				},
 			},
 		},
 	}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/xpladev/xpla/x/burn/types"
+)
+
+// CreateBurnSchedule stores a new burn schedule whose first burn is executed
+// after one interval
+func (k Keeper) CreateBurnSchedule(ctx context.Context, amountPerBurn sdk.Coins, intervalBlocks uint64, totalAmount sdk.Coins) (uint64, error) {
+	id, err := k.BurnScheduleSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	schedule := types.BurnSchedule{
+		Id:             id,
+		AmountPerBurn:  amountPerBurn,
+		IntervalBlocks: intervalBlocks,
+		TotalAmount:    totalAmount,
+		BurnedAmount:   sdk.NewCoins(),
+		NextBurnHeight: sdk.UnwrapSDKContext(ctx).BlockHeight() + int64(intervalBlocks),
+	}
+
+	if err := k.BurnSchedules.Set(ctx, id, schedule); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ExecuteDueBurnSchedules burns the community pool funds of every burn
+// schedule due at the current height. A schedule which cannot be funded is
+// retried after its next interval.
+func (k Keeper) ExecuteDueBurnSchedules(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var dueSchedules []types.BurnSchedule
+	err := k.BurnSchedules.Walk(ctx, nil, func(_ uint64, schedule types.BurnSchedule) (stop bool, err error) {
+		if schedule.NextBurnHeight <= sdkCtx.BlockHeight() {
+			dueSchedules = append(dueSchedules, schedule)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, schedule := range dueSchedules {
+		amount := schedule.AmountPerBurn.Min(schedule.Remaining())
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.burnFromCommunityPool(cacheCtx, amount); err != nil {
+			k.Logger(sdkCtx).Error("failed to execute burn schedule", "schedule_id", schedule.Id, "err", err)
+
+			schedule.NextBurnHeight = sdkCtx.BlockHeight() + int64(schedule.IntervalBlocks)
+			if err := k.BurnSchedules.Set(ctx, schedule.Id, schedule); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+
+		burnScheduleHistory := types.BurnScheduleHistory{
+			ScheduleId: schedule.Id,
+			Height:     sdkCtx.BlockHeight(),
+			Amount:     amount,
+		}
+		if err := k.BurnScheduleHistories.Set(ctx, collections.Join(schedule.Id, sdkCtx.BlockHeight()), burnScheduleHistory); err != nil {
+			return err
+		}
+
+		schedule.BurnedAmount = schedule.BurnedAmount.Add(amount...)
+		schedule.NextBurnHeight = sdkCtx.BlockHeight() + int64(schedule.IntervalBlocks)
+
+		completed := schedule.Remaining().IsZero()
+		if completed {
+			err = k.BurnSchedules.Remove(ctx, schedule.Id)
+		} else {
+			err = k.BurnSchedules.Set(ctx, schedule.Id, schedule)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventBurnScheduleExecuted{
+			ScheduleId: schedule.Id,
+			Amount:     amount,
+			Completed:  completed,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetAllBurnSchedules retrieves all active burn schedules
+func (k Keeper) GetAllBurnSchedules(ctx context.Context) []types.BurnSchedule {
+	burnSchedules := make([]types.BurnSchedule, 0)
+	err := k.BurnSchedules.Walk(ctx, nil, func(_ uint64, schedule types.BurnSchedule) (stop bool, err error) {
+		burnSchedules = append(burnSchedules, schedule)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return burnSchedules
+}
+
+// GetAllBurnScheduleHistories retrieves all burn schedule histories
+func (k Keeper) GetAllBurnScheduleHistories(ctx context.Context) []types.BurnScheduleHistory {
+	burnScheduleHistories := make([]types.BurnScheduleHistory, 0)
+	err := k.BurnScheduleHistories.Walk(ctx, nil, func(_ collections.Pair[uint64, int64], history types.BurnScheduleHistory) (stop bool, err error) {
+		burnScheduleHistories = append(burnScheduleHistories, history)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return burnScheduleHistories
+}
+
+// burnFromCommunityPool moves the amount out of the community pool into the
+// burn module account and burns it. The module account is created first, since
+// sending to its address would otherwise create a base account which can not
+// burn.
+func (k Keeper) burnFromCommunityPool(ctx context.Context, amount sdk.Coins) error {
+	burnAccount := k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, amount, burnAccount.GetAddress()); err != nil {
+		return err
+	}
+
+	return k.BurnCoins(ctx, amount)
+}
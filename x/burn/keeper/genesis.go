@@ -3,20 +3,68 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	"github.com/xpladev/xpla/x/burn/types"
 )
 
 // InitGenesis initializes the bank module's state from a given genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
 	for _, proposal := range genState.OngoingBurnProposals {
 		k.OngoingBurnProposals.Set(ctx, proposal.ProposalId, proposal)
 	}
+
+	if err := k.AddTotalBurned(ctx, genState.TotalBurned); err != nil {
+		panic(err)
+	}
+
+	for _, history := range genState.BurnHistories {
+		if err := k.BurnHistories.Set(ctx, history.ProposalId, history); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, schedule := range genState.BurnSchedules {
+		if err := k.BurnSchedules.Set(ctx, schedule.Id, schedule); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.BurnScheduleSeq.Set(ctx, genState.NextBurnScheduleId); err != nil {
+		panic(err)
+	}
+
+	for _, history := range genState.BurnScheduleHistories {
+		if err := k.BurnScheduleHistories.Set(ctx, collections.Join(history.ScheduleId, history.Height), history); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
 func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	nextBurnScheduleId, err := k.BurnScheduleSeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	rv := types.NewGenesisState(
 		k.GetAllOngoingBurnProposals(ctx),
+		k.GetTotalBurned(ctx),
+		k.GetAllBurnHistories(ctx),
+		k.GetAllBurnSchedules(ctx),
+		nextBurnScheduleId,
+		k.GetAllBurnScheduleHistories(ctx),
+		params,
 	)
 	return rv
 }
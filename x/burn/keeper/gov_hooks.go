@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -36,6 +38,8 @@ func (h BankGovHooks) AfterProposalSubmission(ctx context.Context, proposalID ui
 		return err
 	}
 
+	// Aggregate the amounts of all MsgBurn in the proposal
+	amount := sdk.NewCoins()
 	for _, msg := range res.Proposal.Messages {
 		msgBurn, err := types.UnpackMsgBurn(h.keeper.cdc, msg)
 		if err != nil {
@@ -43,22 +47,45 @@ func (h BankGovHooks) AfterProposalSubmission(ctx context.Context, proposalID ui
 			continue
 		}
 
-		burnProposal := types.BurnProposal{
-			ProposalId: proposalID,
-			Proposer:   proposer.String(),
-			Amount:     msgBurn.Amount,
-		}
+		amount = amount.Add(msgBurn.Amount...)
+	}
 
-		if err := h.keeper.OngoingBurnProposals.Set(ctx, proposalID, burnProposal); err != nil {
-			return err
-		}
+	if amount.IsZero() {
+		return nil
+	}
 
-		if err := h.bankKeeper.SendCoinsFromAccountToModule(ctx, proposer, types.ModuleName, msgBurn.Amount); err != nil {
-			return err
-		}
+	params, err := h.keeper.GetParams(ctx)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	if err := params.ValidateBurnAmount(amount); err != nil {
+		return err
+	}
+
+	if balance := h.bankKeeper.SpendableCoins(ctx, proposer); !balance.IsAllGTE(params.MinProposerBalance) {
+		return errorsmod.Wrapf(types.ErrInsufficientProposerBalance, "%s is smaller than %s", balance, params.MinProposerBalance)
+	}
+
+	burnProposal := types.BurnProposal{
+		ProposalId: proposalID,
+		Proposer:   proposer.String(),
+		Amount:     amount,
+	}
+
+	if err := h.keeper.OngoingBurnProposals.Set(ctx, proposalID, burnProposal); err != nil {
+		return err
+	}
+
+	if err := h.bankKeeper.SendCoinsFromAccountToModule(ctx, proposer, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBurnEscrowed{
+		ProposalId: proposalID,
+		Proposer:   proposer.String(),
+		Amount:     amount,
+	})
 }
 
 // AfterProposalDeposit implements govtypes.GovHooks
@@ -97,7 +124,11 @@ func (h BankGovHooks) AfterProposalFailedMinDeposit(ctx context.Context, proposa
 		return err
 	}
 
-	return nil
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBurnRefunded{
+		ProposalId: proposalID,
+		Proposer:   burnProposal.Proposer,
+		Amount:     burnProposal.Amount,
+	})
 }
 
 // AfterProposalVotingPeriodEnded implements govtypes.GovHooks
@@ -115,33 +146,47 @@ func (h BankGovHooks) AfterProposalVotingPeriodEnded(ctx context.Context, propos
 		return err
 	}
 
+	burnProposal, err := h.keeper.OngoingBurnProposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
 	if err := h.keeper.OngoingBurnProposals.Remove(ctx, proposalID); err != nil {
 		return err
 	}
 
 	// If proposal passed, burn amount stays in gov module (will be burned)
 	if res.Proposal.Status == govv1types.ProposalStatus_PROPOSAL_STATUS_PASSED {
-		return nil
+		burnHistory := types.BurnHistory{
+			ProposalId: proposalID,
+			Height:     sdk.UnwrapSDKContext(ctx).BlockHeight(),
+			Amount:     burnProposal.Amount,
+		}
+
+		if err := h.keeper.BurnHistories.Set(ctx, proposalID, burnHistory); err != nil {
+			return err
+		}
+
+		return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBurnExecuted{
+			ProposalId: proposalID,
+			Proposer:   burnProposal.Proposer,
+			Amount:     burnProposal.Amount,
+		})
 	}
 
-	// If proposal failed, return burn amount to proposer
-	proposer, err := sdk.AccAddressFromBech32(res.Proposal.Proposer)
+	// If proposal failed, return the whole escrowed amount to proposer
+	proposer, err := sdk.AccAddressFromBech32(burnProposal.Proposer)
 	if err != nil {
 		return err
 	}
 
-	// Find the burn amount from the proposal messages
-	for _, msg := range res.Proposal.Messages {
-		msgBurn, err := types.UnpackMsgBurn(h.keeper.cdc, msg)
-		if err != nil {
-			// Skip if not MsgBurn
-			continue
-		}
-
-		if err := h.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposer, msgBurn.Amount); err != nil {
-			return err
-		}
+	if err := h.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposer, burnProposal.Amount); err != nil {
+		return err
 	}
 
-	return nil
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBurnRefunded{
+		ProposalId: proposalID,
+		Proposer:   burnProposal.Proposer,
+		Amount:     burnProposal.Amount,
+	})
 }
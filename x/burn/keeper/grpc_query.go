@@ -3,7 +3,10 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/xpladev/xpla/x/burn/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -15,15 +18,57 @@ type Querier struct {
 
 var _ types.QueryServer = Querier{}
 
+// Params queries params of burn module
+func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
 func (k Querier) OngoingProposals(c context.Context, req *types.QueryOngoingProposalsRequest) (*types.QueryOngoingProposalsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	if req.Proposer != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Proposer); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
-	proposals := k.GetAllOngoingBurnProposals(ctx)
+	proposals, pageRes, err := query.CollectionFilteredPaginate(ctx, k.OngoingBurnProposals, req.Pagination, func(_ uint64, proposal types.BurnProposal) (bool, error) {
+		if req.Proposer != "" && proposal.Proposer != req.Proposer {
+			return false, nil
+		}
 
-	return &types.QueryOngoingProposalsResponse{Proposals: proposals}, nil
+		if req.Denom != "" && proposal.Amount.AmountOf(req.Denom).IsZero() {
+			return false, nil
+		}
+
+		return true, nil
+	}, func(_ uint64, proposal types.BurnProposal) (types.BurnProposal, error) {
+		return proposal, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOngoingProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
 }
 
 func (k Querier) OngoingProposal(c context.Context, req *types.QueryOngoingProposalRequest) (*types.QueryOngoingProposalResponse, error) {
@@ -42,3 +87,62 @@ func (k Querier) OngoingProposal(c context.Context, req *types.QueryOngoingPropo
 		Amount:   proposal.Amount,
 	}, nil
 }
+
+func (k Querier) TotalBurned(c context.Context, req *types.QueryTotalBurnedRequest) (*types.QueryTotalBurnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	totalBurned := k.GetTotalBurned(ctx)
+
+	return &types.QueryTotalBurnedResponse{TotalBurned: totalBurned}, nil
+}
+
+func (k Querier) BurnHistory(c context.Context, req *types.QueryBurnHistoryRequest) (*types.QueryBurnHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	histories, pageRes, err := query.CollectionPaginate(ctx, k.BurnHistories, req.Pagination, func(_ uint64, history types.BurnHistory) (types.BurnHistory, error) {
+		return history, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurnHistoryResponse{Histories: histories, Pagination: pageRes}, nil
+}
+
+func (k Querier) BurnSchedules(c context.Context, req *types.QueryBurnSchedulesRequest) (*types.QueryBurnSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	schedules, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.BurnSchedules, req.Pagination, func(_ uint64, schedule types.BurnSchedule) (types.BurnSchedule, error) {
+		return schedule, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurnSchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+func (k Querier) BurnScheduleHistory(c context.Context, req *types.QueryBurnScheduleHistoryRequest) (*types.QueryBurnScheduleHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	histories, pageRes, err := query.CollectionPaginate(ctx, k.BurnScheduleHistories, req.Pagination, func(_ collections.Pair[uint64, int64], history types.BurnScheduleHistory) (types.BurnScheduleHistory, error) {
+		return history, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurnScheduleHistoryResponse{Histories: histories, Pagination: pageRes}, nil
+}
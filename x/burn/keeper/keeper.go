@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,11 +19,19 @@ import (
 type Keeper struct {
 	cdc          codec.BinaryCodec
 	storeService store.KVStoreService
+	authKeeper   types.AccountKeeper
 	bankKeeper   types.BankKeeper
+	distrKeeper  types.DistributionKeeper
 	authority    string
 
-	OngoingBurnProposals collections.Map[uint64, types.BurnProposal]
-	Schema               collections.Schema
+	OngoingBurnProposals  collections.Map[uint64, types.BurnProposal]
+	TotalBurned           collections.Map[string, sdkmath.Int]
+	BurnHistories         collections.Map[uint64, types.BurnHistory]
+	BurnSchedules         collections.Map[uint64, types.BurnSchedule]
+	BurnScheduleSeq       collections.Sequence
+	BurnScheduleHistories collections.Map[collections.Pair[uint64, int64], types.BurnScheduleHistory]
+	Params                collections.Item[types.Params]
+	Schema                collections.Schema
 }
 
 func NewKeeper(
@@ -29,6 +39,7 @@ func NewKeeper(
 	storeService store.KVStoreService,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	dk types.DistributionKeeper,
 	authority string,
 ) Keeper {
 	// ensure burn module account is set
@@ -38,6 +49,12 @@ func NewKeeper(
 
 	sb := collections.NewSchemaBuilder(storeService)
 	ongoingBurnProposals := collections.NewMap(sb, types.OngoingBurnProposalsPrefix, "ongoing_burn_proposals", collections.Uint64Key, codec.CollValue[types.BurnProposal](cdc))
+	totalBurned := collections.NewMap(sb, types.TotalBurnedPrefix, "total_burned", collections.StringKey, sdk.IntValue)
+	burnHistories := collections.NewMap(sb, types.BurnHistoriesPrefix, "burn_histories", collections.Uint64Key, codec.CollValue[types.BurnHistory](cdc))
+	burnSchedules := collections.NewMap(sb, types.BurnSchedulesPrefix, "burn_schedules", collections.Uint64Key, codec.CollValue[types.BurnSchedule](cdc))
+	burnScheduleSeq := collections.NewSequence(sb, types.BurnScheduleSeqPrefix, "burn_schedule_seq")
+	burnScheduleHistories := collections.NewMap(sb, types.BurnScheduleHistoriesPrefix, "burn_schedule_histories", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.BurnScheduleHistory](cdc))
+	params := collections.NewItem(sb, types.ParamsPrefix, "params", codec.CollValue[types.Params](cdc))
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -45,12 +62,20 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:                  cdc,
-		storeService:         storeService,
-		bankKeeper:           bk,
-		authority:            authority,
-		OngoingBurnProposals: ongoingBurnProposals,
-		Schema:               schema,
+		cdc:                   cdc,
+		storeService:          storeService,
+		authKeeper:            ak,
+		bankKeeper:            bk,
+		distrKeeper:           dk,
+		authority:             authority,
+		OngoingBurnProposals:  ongoingBurnProposals,
+		TotalBurned:           totalBurned,
+		BurnHistories:         burnHistories,
+		BurnSchedules:         burnSchedules,
+		BurnScheduleSeq:       burnScheduleSeq,
+		BurnScheduleHistories: burnScheduleHistories,
+		Params:                params,
+		Schema:                schema,
 	}
 }
 
@@ -85,3 +110,60 @@ func (k Keeper) IterateAllOngoingBurnProposals(ctx context.Context, cb func(type
 		panic(err)
 	}
 }
+
+// BurnCoins burns coins held by the burn module account and adds them to
+// the cumulative burned supply
+func (k Keeper) BurnCoins(ctx context.Context, amount sdk.Coins) error {
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	return k.AddTotalBurned(ctx, amount)
+}
+
+// AddTotalBurned adds the given coins to the cumulative burned supply
+func (k Keeper) AddTotalBurned(ctx context.Context, amount sdk.Coins) error {
+	for _, coin := range amount {
+		total, err := k.TotalBurned.Get(ctx, coin.Denom)
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+			total = sdkmath.ZeroInt()
+		}
+
+		if err := k.TotalBurned.Set(ctx, coin.Denom, total.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetTotalBurned retrieves the cumulative burned supply of all denoms
+func (k Keeper) GetTotalBurned(ctx context.Context) sdk.Coins {
+	totalBurned := sdk.NewCoins()
+	err := k.TotalBurned.Walk(ctx, nil, func(denom string, amount sdkmath.Int) (stop bool, err error) {
+		totalBurned = totalBurned.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return totalBurned
+}
+
+// GetAllBurnHistories retrieves all burn histories
+func (k Keeper) GetAllBurnHistories(ctx context.Context) []types.BurnHistory {
+	burnHistories := make([]types.BurnHistory, 0)
+	err := k.BurnHistories.Walk(ctx, nil, func(_ uint64, history types.BurnHistory) (stop bool, err error) {
+		burnHistories = append(burnHistories, history)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return burnHistories
+}
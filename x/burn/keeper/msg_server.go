@@ -36,11 +36,142 @@ func (k msgServer) Burn(goCtx context.Context, req *types.MsgBurn) (*types.MsgBu
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, req.Amount.String())
 	}
 
-	// Burn the coins from gov module account
-	err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, req.Amount)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := params.ValidateBurnAmount(req.Amount); err != nil {
+		return nil, err
+	}
+
+	// Burn the coins escrowed in the burn module account. The gov hooks emit
+	// EventBurnExecuted for the whole proposal once it is settled.
+	err = k.BurnCoins(ctx, req.Amount)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to burn coins")
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		Authority: req.Authority,
+		Amount:    req.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgBurnResponse{}, nil
 }
+
+// BurnOwn implements burn MsgServer for burning coins from the signer's own account.
+func (k msgServer) BurnOwn(goCtx context.Context, req *types.MsgBurnOwn) (*types.MsgBurnOwnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	burner, err := sdk.AccAddressFromBech32(req.Burner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	// Validate amount
+	if !req.Amount.IsValid() || !req.Amount.IsAllPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, req.Amount.String())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, burner, types.ModuleName, req.Amount); err != nil {
+		return nil, err
+	}
+
+	// Burn the coins from burn module account
+	if err := k.BurnCoins(ctx, req.Amount); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to burn coins")
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurnOwn{
+		Burner: req.Burner,
+		Amount: req.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnOwnResponse{}, nil
+}
+
+// CreateBurnSchedule implements burn MsgServer for creating a recurring burn of community pool funds.
+func (k msgServer) CreateBurnSchedule(goCtx context.Context, req *types.MsgCreateBurnSchedule) (*types.MsgCreateBurnScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := types.ValidateBurnScheduleAmounts(req.AmountPerBurn, req.IntervalBlocks, req.TotalAmount); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidBurnSchedule, err.Error())
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the total amount has the same denoms as the amount per burn
+	if err := params.ValidateBurnAmount(req.AmountPerBurn); err != nil {
+		return nil, err
+	}
+
+	scheduleId, err := k.Keeper.CreateBurnSchedule(ctx, req.AmountPerBurn, req.IntervalBlocks, req.TotalAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurnScheduleCreated{
+		ScheduleId: scheduleId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateBurnScheduleResponse{ScheduleId: scheduleId}, nil
+}
+
+// CancelBurnSchedule implements burn MsgServer for cancelling an active burn schedule.
+func (k msgServer) CancelBurnSchedule(goCtx context.Context, req *types.MsgCancelBurnSchedule) (*types.MsgCancelBurnScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	has, err := k.BurnSchedules.Has(ctx, req.ScheduleId)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errorsmod.Wrapf(types.ErrBurnScheduleNotFound, "schedule id %d", req.ScheduleId)
+	}
+
+	if err := k.BurnSchedules.Remove(ctx, req.ScheduleId); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurnScheduleCancelled{
+		ScheduleId: req.ScheduleId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelBurnScheduleResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the parameters in the keeper only if the requested authority
+// is the Cosmos SDK governance module account
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
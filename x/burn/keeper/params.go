@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/xpladev/xpla/x/burn/types"
+)
+
+// GetParams returns the parameters of the burn module
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	return k.Params.Get(ctx)
+}
+
+// SetParams validates and sets the parameters of the burn module
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, params)
+}
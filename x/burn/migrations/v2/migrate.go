@@ -0,0 +1,15 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/xpladev/xpla/x/burn/types"
+)
+
+// MigrateStore sets the default parameters of the x/burn module, which did
+// not have any parameters before consensus version 2.
+func MigrateStore(ctx context.Context, params collections.Item[types.Params]) error {
+	return params.Set(ctx, types.DefaultParams())
+}
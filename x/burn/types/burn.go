@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -24,3 +25,77 @@ func (b BurnProposal) Validate() error {
 
 	return nil
 }
+
+func (b BurnHistory) Validate() error {
+	if b.ProposalId == 0 {
+		return errors.New("proposal ID cannot be 0")
+	}
+
+	if b.Height < 0 {
+		return errors.New("height cannot be negative")
+	}
+
+	if err := b.Amount.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b BurnScheduleHistory) Validate() error {
+	if b.Height < 0 {
+		return errors.New("height cannot be negative")
+	}
+
+	if !b.Amount.IsValid() || !b.Amount.IsAllPositive() {
+		return fmt.Errorf("invalid burn schedule history amount: %s", b.Amount)
+	}
+
+	return nil
+}
+
+// ValidateBurnScheduleAmounts validates the amounts of a burn schedule
+func ValidateBurnScheduleAmounts(amountPerBurn sdk.Coins, intervalBlocks uint64, totalAmount sdk.Coins) error {
+	if !amountPerBurn.IsValid() || !amountPerBurn.IsAllPositive() {
+		return fmt.Errorf("invalid amount per burn: %s", amountPerBurn)
+	}
+
+	if intervalBlocks == 0 {
+		return errors.New("interval blocks cannot be 0")
+	}
+
+	if !totalAmount.IsValid() || !totalAmount.IsAllPositive() {
+		return fmt.Errorf("invalid total amount: %s", totalAmount)
+	}
+
+	if !amountPerBurn.DenomsSubsetOf(totalAmount) || !totalAmount.DenomsSubsetOf(amountPerBurn) {
+		return errors.New("amount per burn and total amount must have the same denoms")
+	}
+
+	return nil
+}
+
+func (b BurnSchedule) Validate() error {
+	if err := ValidateBurnScheduleAmounts(b.AmountPerBurn, b.IntervalBlocks, b.TotalAmount); err != nil {
+		return err
+	}
+
+	if err := b.BurnedAmount.Validate(); err != nil {
+		return err
+	}
+
+	if !b.TotalAmount.IsAllGTE(b.BurnedAmount) {
+		return errors.New("burned amount cannot exceed total amount")
+	}
+
+	if b.NextBurnHeight < 0 {
+		return errors.New("next burn height cannot be negative")
+	}
+
+	return nil
+}
+
+// Remaining returns the amount left to burn before the schedule is completed
+func (b BurnSchedule) Remaining() sdk.Coins {
+	return b.TotalAmount.Sub(b.BurnedAmount...)
+}
@@ -29,9 +29,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // BurnProposal defines a ongoingburn proposal
 type BurnProposal struct {
-	ProposalId uint64                                   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	Proposer   string                                   `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	Amount     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Proposer   string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// amount is the escrowed sum of all MsgBurn amounts in the proposal
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
 func (m *BurnProposal) Reset()         { *m = BurnProposal{} }
@@ -88,34 +89,342 @@ func (m *BurnProposal) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// BurnHistory defines a burn executed by a passed burn proposal
+type BurnHistory struct {
+	// proposal_id is the id of the executed burn proposal
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// height is the block height at which the burn was executed
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// amount is the burned amount
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *BurnHistory) Reset()         { *m = BurnHistory{} }
+func (m *BurnHistory) String() string { return proto.CompactTextString(m) }
+func (*BurnHistory) ProtoMessage()    {}
+func (*BurnHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08b472580b6d9700, []int{1}
+}
+func (m *BurnHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnHistory.Merge(m, src)
+}
+func (m *BurnHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnHistory proto.InternalMessageInfo
+
+func (m *BurnHistory) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *BurnHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BurnHistory) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// BurnSchedule defines a governance-approved recurring burn funded by the
+// community pool
+type BurnSchedule struct {
+	// id is the unique identifier of the burn schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// amount_per_burn is the amount burned at every execution
+	AmountPerBurn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount_per_burn,json=amountPerBurn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount_per_burn"`
+	// interval_blocks is the number of blocks between two executions
+	IntervalBlocks uint64 `protobuf:"varint,3,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// total_amount is the amount after which the schedule is completed
+	TotalAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_amount,json=totalAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_amount"`
+	// burned_amount is the amount burned by the schedule so far
+	BurnedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=burned_amount,json=burnedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_amount"`
+	// next_burn_height is the block height of the next execution
+	NextBurnHeight int64 `protobuf:"varint,6,opt,name=next_burn_height,json=nextBurnHeight,proto3" json:"next_burn_height,omitempty"`
+}
+
+func (m *BurnSchedule) Reset()         { *m = BurnSchedule{} }
+func (m *BurnSchedule) String() string { return proto.CompactTextString(m) }
+func (*BurnSchedule) ProtoMessage()    {}
+func (*BurnSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08b472580b6d9700, []int{2}
+}
+func (m *BurnSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnSchedule.Merge(m, src)
+}
+func (m *BurnSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnSchedule proto.InternalMessageInfo
+
+func (m *BurnSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BurnSchedule) GetAmountPerBurn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AmountPerBurn
+	}
+	return nil
+}
+
+func (m *BurnSchedule) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
+}
+
+func (m *BurnSchedule) GetTotalAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return nil
+}
+
+func (m *BurnSchedule) GetBurnedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedAmount
+	}
+	return nil
+}
+
+func (m *BurnSchedule) GetNextBurnHeight() int64 {
+	if m != nil {
+		return m.NextBurnHeight
+	}
+	return 0
+}
+
+// BurnScheduleHistory defines a single execution of a burn schedule
+type BurnScheduleHistory struct {
+	// schedule_id is the id of the executed burn schedule
+	ScheduleId uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// height is the block height at which the burn was executed
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// amount is the burned amount
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *BurnScheduleHistory) Reset()         { *m = BurnScheduleHistory{} }
+func (m *BurnScheduleHistory) String() string { return proto.CompactTextString(m) }
+func (*BurnScheduleHistory) ProtoMessage()    {}
+func (*BurnScheduleHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08b472580b6d9700, []int{3}
+}
+func (m *BurnScheduleHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnScheduleHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnScheduleHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnScheduleHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnScheduleHistory.Merge(m, src)
+}
+func (m *BurnScheduleHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnScheduleHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnScheduleHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnScheduleHistory proto.InternalMessageInfo
+
+func (m *BurnScheduleHistory) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+func (m *BurnScheduleHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BurnScheduleHistory) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// Params defines the set of params for the burn module.
+type Params struct {
+	// allowed_denoms is the list of denoms which can be burned by governance
+	AllowedDenoms []string `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// max_burn_amounts is the maximum amount of a denom which can be burned by
+	// a single burn proposal. Denoms without an entry are not capped.
+	MaxBurnAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=max_burn_amounts,json=maxBurnAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_burn_amounts"`
+	// min_proposer_balance is the minimum spendable balance the proposer of a
+	// burn proposal must hold at submission
+	MinProposerBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_proposer_balance,json=minProposerBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_proposer_balance"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08b472580b6d9700, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMaxBurnAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxBurnAmounts
+	}
+	return nil
+}
+
+func (m *Params) GetMinProposerBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinProposerBalance
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BurnProposal)(nil), "xpla.burn.v1beta1.BurnProposal")
+	proto.RegisterType((*BurnHistory)(nil), "xpla.burn.v1beta1.BurnHistory")
+	proto.RegisterType((*BurnSchedule)(nil), "xpla.burn.v1beta1.BurnSchedule")
+	proto.RegisterType((*BurnScheduleHistory)(nil), "xpla.burn.v1beta1.BurnScheduleHistory")
+	proto.RegisterType((*Params)(nil), "xpla.burn.v1beta1.Params")
 }
 
 func init() { proto.RegisterFile("xpla/burn/v1beta1/burn.proto", fileDescriptor_08b472580b6d9700) }
 
 var fileDescriptor_08b472580b6d9700 = []byte{
-	// 316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xa9, 0x28, 0xc8, 0x49,
-	0xd4, 0x4f, 0x2a, 0x2d, 0xca, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0x04, 0x73, 0xf4,
-	0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x41, 0xb2, 0x7a, 0x60, 0x01, 0xa8, 0xac, 0x94, 0x5c,
-	0x72, 0x7e, 0x71, 0x6e, 0x7e, 0xb1, 0x7e, 0x52, 0x62, 0x71, 0x2a, 0x5c, 0x4b, 0x72, 0x7e, 0x26,
-	0x54, 0x8b, 0x94, 0x24, 0x44, 0x3e, 0x1e, 0xcc, 0xd3, 0x87, 0x70, 0xa0, 0x52, 0x82, 0x89, 0xb9,
-	0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x2a, 0x24, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x51, 0x0a, 0x62,
-	0x41, 0x44, 0x95, 0xce, 0x33, 0x72, 0xf1, 0x38, 0x95, 0x16, 0xe5, 0x05, 0x14, 0xe5, 0x17, 0xe4,
-	0x17, 0x27, 0xe6, 0x08, 0xc9, 0x73, 0x71, 0x17, 0x40, 0xd9, 0xf1, 0x99, 0x29, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x2c, 0x41, 0x5c, 0x30, 0x21, 0xcf, 0x14, 0x21, 0x13, 0x2e, 0x0e, 0x08, 0x2f, 0xb5,
-	0x48, 0x82, 0x49, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0xf5, 0x8e,
-	0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1, 0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x70, 0x95, 0x42,
-	0x19, 0x5c, 0x6c, 0x89, 0xb9, 0xf9, 0xa5, 0x79, 0x25, 0x12, 0xcc, 0x0a, 0xcc, 0x1a, 0xdc, 0x46,
-	0x92, 0x7a, 0x50, 0x0d, 0x20, 0xcf, 0xc1, 0x7c, 0xac, 0xe7, 0x9c, 0x9f, 0x99, 0xe7, 0x64, 0x7a,
-	0xe2, 0x9e, 0x3c, 0xc3, 0xaa, 0xfb, 0xf2, 0x1a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9,
-	0xf9, 0xb9, 0x50, 0xcf, 0x41, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0x62,
-	0xb0, 0x86, 0xe2, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41, 0xcd, 0x77, 0xb2, 0x3b, 0xf1, 0x48,
-	0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x15, 0x24, 0x03, 0x41, 0xa1, 0x9d, 0x92, 0x5a,
-	0x06, 0xa6, 0xf5, 0x2b, 0x20, 0xb1, 0x02, 0x36, 0x32, 0x89, 0x0d, 0x1c, 0x30, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xed, 0xff, 0x08, 0xe3, 0xaf, 0x01, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xce, 0xc5, 0xfd, 0x45, 0xbf, 0x5e, 0xda, 0xb4, 0x3d, 0x0a, 0x72, 0x2b, 0xe4, 0x44, 0x11,
+	0x08, 0xab, 0x52, 0x63, 0x95, 0x3f, 0x0b, 0x03, 0x52, 0x0d, 0x03, 0x6c, 0x51, 0xba, 0xb1, 0x58,
+	0x67, 0xfb, 0x94, 0x9c, 0x6a, 0xfb, 0xac, 0xbb, 0x73, 0x70, 0x19, 0x19, 0x99, 0xf8, 0x18, 0x88,
+	0x29, 0x03, 0x03, 0x1f, 0xa1, 0x03, 0x12, 0x15, 0x0b, 0x4c, 0x80, 0x92, 0xa1, 0x5f, 0x03, 0xf9,
+	0xee, 0x1c, 0x65, 0x63, 0x0a, 0x62, 0x49, 0xee, 0x7d, 0xde, 0x7b, 0xef, 0x79, 0xff, 0x3c, 0x7a,
+	0x0d, 0x6f, 0x97, 0x79, 0x82, 0xbd, 0xb0, 0xe0, 0x99, 0x37, 0x3d, 0x09, 0x89, 0xc4, 0x27, 0xca,
+	0x18, 0xe4, 0x9c, 0x49, 0x86, 0xf6, 0x2a, 0xef, 0x40, 0x01, 0xc6, 0x7b, 0xe8, 0x44, 0x4c, 0xa4,
+	0x4c, 0x78, 0x21, 0x16, 0x64, 0x19, 0x12, 0x31, 0x6a, 0x42, 0x0e, 0x0f, 0xb4, 0x3f, 0x50, 0x96,
+	0xa7, 0x0d, 0xe3, 0xda, 0xc3, 0x29, 0xcd, 0x98, 0xa7, 0x7e, 0x0d, 0xb4, 0x3f, 0x66, 0x63, 0xa6,
+	0xaf, 0x56, 0x27, 0x8d, 0xf6, 0xbf, 0x00, 0xb8, 0xe5, 0x17, 0x3c, 0x1b, 0x72, 0x96, 0x33, 0x81,
+	0x13, 0xd4, 0x85, 0xed, 0xdc, 0x9c, 0x03, 0x1a, 0xdb, 0xa0, 0x07, 0xdc, 0x8d, 0x11, 0xac, 0xa1,
+	0x17, 0x31, 0x7a, 0x08, 0xff, 0xd7, 0x16, 0xe1, 0x76, 0xb3, 0x07, 0xdc, 0x4d, 0xdf, 0xfe, 0xfa,
+	0xf1, 0x78, 0xdf, 0xd0, 0x9f, 0xc6, 0x31, 0x27, 0x42, 0x9c, 0x49, 0x4e, 0xb3, 0xf1, 0x68, 0x79,
+	0x13, 0x4d, 0x60, 0x0b, 0xa7, 0xac, 0xc8, 0xa4, 0x6d, 0xf5, 0x2c, 0xb7, 0x7d, 0xff, 0x60, 0x60,
+	0x02, 0xaa, 0xe2, 0xea, 0x8a, 0x07, 0x4f, 0x19, 0xcd, 0xfc, 0x47, 0x97, 0x3f, 0xba, 0x8d, 0x0f,
+	0x3f, 0xbb, 0xee, 0x98, 0xca, 0x49, 0x11, 0x0e, 0x22, 0x96, 0x9a, 0xe2, 0xcc, 0xdf, 0xb1, 0x88,
+	0xcf, 0x3d, 0x79, 0x91, 0x13, 0xa1, 0x02, 0xc4, 0xfb, 0xeb, 0xd9, 0x11, 0x18, 0x99, 0xf7, 0xfb,
+	0x33, 0x00, 0xdb, 0x55, 0x45, 0xcf, 0xa9, 0x90, 0x8c, 0x5f, 0xfc, 0xb9, 0xa0, 0x5b, 0xb0, 0x35,
+	0x21, 0x74, 0x3c, 0x91, 0xaa, 0x1c, 0x6b, 0x64, 0xac, 0xbf, 0x98, 0xf2, 0x37, 0x4b, 0x0f, 0xe1,
+	0x2c, 0x9a, 0x90, 0xb8, 0x48, 0x08, 0xea, 0xc0, 0xe6, 0x32, 0xd5, 0x26, 0x8d, 0x51, 0x09, 0x77,
+	0xf4, 0xd5, 0x20, 0x27, 0x3c, 0xa8, 0x44, 0x62, 0x37, 0xd7, 0x94, 0xd3, 0xb6, 0x26, 0x1a, 0x12,
+	0x5e, 0x65, 0x84, 0xee, 0xc1, 0x1d, 0x9a, 0x49, 0xc2, 0xa7, 0x38, 0x09, 0xc2, 0x84, 0x45, 0xe7,
+	0xc2, 0xb6, 0x54, 0x5a, 0x9d, 0x1a, 0xf6, 0x15, 0x8a, 0x04, 0xdc, 0x92, 0x4c, 0xe2, 0x24, 0x30,
+	0x3d, 0xdb, 0x58, 0x53, 0x7e, 0x6d, 0xc5, 0x72, 0xaa, 0x48, 0x50, 0x01, 0xb7, 0xab, 0x66, 0x90,
+	0xb8, 0x66, 0xfd, 0x6f, 0x4d, 0xac, 0x5b, 0x9a, 0xc6, 0xd0, 0xba, 0x70, 0x37, 0x23, 0xa5, 0x54,
+	0x83, 0x08, 0x8c, 0x76, 0x5a, 0x4a, 0x3b, 0x9d, 0x0a, 0x57, 0xea, 0x53, 0x68, 0xff, 0x13, 0x80,
+	0x37, 0x56, 0x27, 0xbb, 0x22, 0x4a, 0x61, 0xa0, 0x15, 0x51, 0xd6, 0xd0, 0x3f, 0x21, 0xca, 0xcf,
+	0x4d, 0xd8, 0x1a, 0x62, 0x8e, 0x53, 0x81, 0xee, 0xc2, 0x0e, 0x4e, 0x12, 0xf6, 0x8a, 0xc4, 0x41,
+	0x4c, 0x32, 0x96, 0x0a, 0x1b, 0xf4, 0x2c, 0x77, 0x73, 0xb4, 0x6d, 0xd0, 0x67, 0x0a, 0x44, 0xaf,
+	0xe1, 0x6e, 0x8a, 0x4b, 0xdd, 0x15, 0xfd, 0x88, 0x58, 0x9b, 0x4c, 0x3b, 0x29, 0x2e, 0xab, 0xc6,
+	0xea, 0x89, 0x08, 0xf4, 0x06, 0xc0, 0xfd, 0x94, 0x66, 0x41, 0xbd, 0x70, 0x82, 0x10, 0x27, 0x38,
+	0x8b, 0xc8, 0xda, 0xda, 0x84, 0x52, 0x6a, 0x96, 0x26, 0xe1, 0xbe, 0xe6, 0x7a, 0x7c, 0xf8, 0xf6,
+	0x7a, 0x76, 0x74, 0xb3, 0x5a, 0xe4, 0x31, 0x99, 0x7a, 0xa5, 0xde, 0xf5, 0xba, 0x87, 0xfe, 0x93,
+	0xcb, 0xb9, 0x03, 0xae, 0xe6, 0x0e, 0xf8, 0x35, 0x77, 0xc0, 0xbb, 0x85, 0xd3, 0xb8, 0x5a, 0x38,
+	0x8d, 0xef, 0x0b, 0xa7, 0xf1, 0xf2, 0xce, 0x0a, 0xf1, 0x32, 0xb6, 0xfa, 0x54, 0x98, 0x07, 0x14,
+	0x75, 0xd8, 0x52, 0xfb, 0xfa, 0xc1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x79, 0x96, 0xae,
+	0x46, 0x06, 0x00, 0x00,
 }
 
 func (m *BurnProposal) Marshal() (dAtA []byte, err error) {
@@ -145,68 +454,873 @@ func (m *BurnProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintBurn(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintBurn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintBurn(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBurn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextBurnHeight != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.NextBurnHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BurnedAmount) > 0 {
+		for iNdEx := len(m.BurnedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBurn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalAmount) > 0 {
+		for iNdEx := len(m.TotalAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBurn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AmountPerBurn) > 0 {
+		for iNdEx := len(m.AmountPerBurn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountPerBurn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBurn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnScheduleHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnScheduleHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnScheduleHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBurn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinProposerBalance) > 0 {
+		for iNdEx := len(m.MinProposerBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinProposerBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBurn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxBurnAmounts) > 0 {
+		for iNdEx := len(m.MaxBurnAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxBurnAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBurn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintBurn(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBurn(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBurn(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BurnProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovBurn(uint64(m.ProposalId))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovBurn(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BurnHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovBurn(uint64(m.ProposalId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBurn(uint64(m.Height))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BurnSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBurn(uint64(m.Id))
+	}
+	if len(m.AmountPerBurn) > 0 {
+		for _, e := range m.AmountPerBurn {
+			l = e.Size()
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovBurn(uint64(m.IntervalBlocks))
+	}
+	if len(m.TotalAmount) > 0 {
+		for _, e := range m.TotalAmount {
+			l = e.Size()
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	if len(m.BurnedAmount) > 0 {
+		for _, e := range m.BurnedAmount {
+			l = e.Size()
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	if m.NextBurnHeight != 0 {
+		n += 1 + sovBurn(uint64(m.NextBurnHeight))
+	}
+	return n
+}
+
+func (m *BurnScheduleHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		n += 1 + sovBurn(uint64(m.ScheduleId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBurn(uint64(m.Height))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	if len(m.MaxBurnAmounts) > 0 {
+		for _, e := range m.MaxBurnAmounts {
+			l = e.Size()
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	if len(m.MinProposerBalance) > 0 {
+		for _, e := range m.MinProposerBalance {
+			l = e.Size()
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBurn(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBurn(x uint64) (n int) {
+	return sovBurn(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BurnProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerBurn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountPerBurn = append(m.AmountPerBurn, types.Coin{})
+			if err := m.AmountPerBurn[len(m.AmountPerBurn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAmount = append(m.TotalAmount, types.Coin{})
+			if err := m.TotalAmount[len(m.TotalAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x1a
+			if msglen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedAmount = append(m.BurnedAmount, types.Coin{})
+			if err := m.BurnedAmount[len(m.BurnedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBurnHeight", wireType)
+			}
+			m.NextBurnHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextBurnHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintBurn(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintBurn(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintBurn(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBurn(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *BurnProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovBurn(uint64(m.ProposalId))
-	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovBurn(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovBurn(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBurn(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBurn(x uint64) (n int) {
-	return sovBurn(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BurnProposal) Unmarshal(dAtA []byte) error {
+func (m *BurnScheduleHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -229,17 +1343,17 @@ func (m *BurnProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BurnProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: BurnScheduleHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BurnProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BurnScheduleHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			m.ProposalId = 0
+			m.ScheduleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBurn
@@ -249,14 +1363,117 @@ func (m *BurnProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.ScheduleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -284,11 +1501,45 @@ func (m *BurnProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBurnAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxBurnAmounts = append(m.MaxBurnAmounts, types.Coin{})
+			if err := m.MaxBurnAmounts[len(m.MaxBurnAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProposerBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -315,8 +1566,8 @@ func (m *BurnProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinProposerBalance = append(m.MinProposerBalance, types.Coin{})
+			if err := m.MinProposerBalance[len(m.MinProposerBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13,11 +13,20 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "xpladev/MsgBurn")
+	legacy.RegisterAminoMsg(cdc, &MsgBurnOwn{}, "xpladev/MsgBurnOwn")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateBurnSchedule{}, "xpladev/MsgCreateBurnSchedule")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelBurnSchedule{}, "xpladev/MsgCancelBurnSchedule")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "xpladev/x/burn/MsgUpdateParams")
+	cdc.RegisterConcrete(Params{}, "xpladev/x/burn/Params", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgBurn{},
+		&MsgBurnOwn{},
+		&MsgCreateBurnSchedule{},
+		&MsgCancelBurnSchedule{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -6,7 +6,11 @@ import (
 
 // x/burn module sentinel errors
 var (
-	ErrBurnProposalNotFound = errorsmod.Register(ModuleName, 1, "burn proposal not found")
-	ErrInvalidBurnAmount    = errorsmod.Register(ModuleName, 2, "invalid burn amount")
-	ErrBurnProposalExists   = errorsmod.Register(ModuleName, 3, "burn proposal already exists")
+	ErrBurnProposalNotFound        = errorsmod.Register(ModuleName, 1, "burn proposal not found")
+	ErrInvalidBurnAmount           = errorsmod.Register(ModuleName, 2, "invalid burn amount")
+	ErrBurnProposalExists          = errorsmod.Register(ModuleName, 3, "burn proposal already exists")
+	ErrBurnScheduleNotFound        = errorsmod.Register(ModuleName, 4, "burn schedule not found")
+	ErrInvalidBurnSchedule         = errorsmod.Register(ModuleName, 5, "invalid burn schedule")
+	ErrDenomNotAllowed             = errorsmod.Register(ModuleName, 6, "denom not allowed to burn")
+	ErrInsufficientProposerBalance = errorsmod.Register(ModuleName, 7, "insufficient proposer balance")
 )
@@ -1,25 +1,100 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Validate performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	proposalIds := make(map[uint64]bool)
 	for _, proposal := range gs.OngoingBurnProposals {
 		if err := proposal.Validate(); err != nil {
 			return err
 		}
+
+		if proposalIds[proposal.ProposalId] {
+			return fmt.Errorf("duplicate ongoing burn proposal ID: %d", proposal.ProposalId)
+		}
+		proposalIds[proposal.ProposalId] = true
+	}
+
+	if err := gs.TotalBurned.Validate(); err != nil {
+		return err
+	}
+
+	historyIds := make(map[uint64]bool)
+	for _, history := range gs.BurnHistories {
+		if err := history.Validate(); err != nil {
+			return err
+		}
+
+		if historyIds[history.ProposalId] {
+			return fmt.Errorf("duplicate burn history proposal ID: %d", history.ProposalId)
+		}
+		historyIds[history.ProposalId] = true
+	}
+
+	scheduleIds := make(map[uint64]bool)
+	for _, schedule := range gs.BurnSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+
+		if scheduleIds[schedule.Id] {
+			return fmt.Errorf("duplicate burn schedule ID: %d", schedule.Id)
+		}
+		scheduleIds[schedule.Id] = true
+
+		if schedule.Id >= gs.NextBurnScheduleId {
+			return fmt.Errorf("burn schedule ID %d must be lower than next burn schedule ID %d", schedule.Id, gs.NextBurnScheduleId)
+		}
+	}
+
+	type scheduleHistoryKey struct {
+		scheduleId uint64
+		height     int64
+	}
+	scheduleHistoryKeys := make(map[scheduleHistoryKey]bool)
+	for _, history := range gs.BurnScheduleHistories {
+		if err := history.Validate(); err != nil {
+			return err
+		}
+
+		if history.ScheduleId >= gs.NextBurnScheduleId {
+			return fmt.Errorf("burn schedule history ID %d must be lower than next burn schedule ID %d", history.ScheduleId, gs.NextBurnScheduleId)
+		}
+
+		key := scheduleHistoryKey{history.ScheduleId, history.Height}
+		if scheduleHistoryKeys[key] {
+			return fmt.Errorf("duplicate burn schedule history: schedule ID %d, height %d", history.ScheduleId, history.Height)
+		}
+		scheduleHistoryKeys[key] = true
 	}
 
 	return nil
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(burnProposals []BurnProposal) *GenesisState {
+func NewGenesisState(burnProposals []BurnProposal, totalBurned sdk.Coins, burnHistories []BurnHistory, burnSchedules []BurnSchedule, nextBurnScheduleId uint64, burnScheduleHistories []BurnScheduleHistory, params Params) *GenesisState {
 	return &GenesisState{
-		OngoingBurnProposals: burnProposals,
+		OngoingBurnProposals:  burnProposals,
+		TotalBurned:           totalBurned,
+		BurnHistories:         burnHistories,
+		BurnSchedules:         burnSchedules,
+		NextBurnScheduleId:    nextBurnScheduleId,
+		BurnScheduleHistories: burnScheduleHistories,
+		Params:                params,
 	}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState([]BurnProposal{})
+	return NewGenesisState([]BurnProposal{}, sdk.Coins{}, []BurnHistory{}, []BurnSchedule{}, 0, []BurnScheduleHistory{}, DefaultParams())
 }
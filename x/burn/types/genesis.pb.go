@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +30,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// ongoing_burn_proposals defines the ongoing burn proposals at genesis
 	OngoingBurnProposals []BurnProposal `protobuf:"bytes,1,rep,name=ongoing_burn_proposals,json=ongoingBurnProposals,proto3" json:"ongoing_burn_proposals"`
+	// total_burned defines the cumulative burned supply per denom at genesis
+	TotalBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_burned,json=totalBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned"`
+	// burn_histories defines the executed burn proposals at genesis
+	BurnHistories []BurnHistory `protobuf:"bytes,3,rep,name=burn_histories,json=burnHistories,proto3" json:"burn_histories"`
+	// burn_schedules defines the active burn schedules at genesis
+	BurnSchedules []BurnSchedule `protobuf:"bytes,4,rep,name=burn_schedules,json=burnSchedules,proto3" json:"burn_schedules"`
+	// next_burn_schedule_id defines the id of the next burn schedule
+	NextBurnScheduleId uint64 `protobuf:"varint,5,opt,name=next_burn_schedule_id,json=nextBurnScheduleId,proto3" json:"next_burn_schedule_id,omitempty"`
+	// params defines all the parameters of the module
+	Params Params `protobuf:"bytes,6,opt,name=params,proto3" json:"params"`
+	// burn_schedule_histories defines the executed burn schedules at genesis
+	BurnScheduleHistories []BurnScheduleHistory `protobuf:"bytes,7,rep,name=burn_schedule_histories,json=burnScheduleHistories,proto3" json:"burn_schedule_histories"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +84,48 @@ func (m *GenesisState) GetOngoingBurnProposals() []BurnProposal {
 	return nil
 }
 
+func (m *GenesisState) GetTotalBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBurned
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnHistories() []BurnHistory {
+	if m != nil {
+		return m.BurnHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnSchedules() []BurnSchedule {
+	if m != nil {
+		return m.BurnSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextBurnScheduleId() uint64 {
+	if m != nil {
+		return m.NextBurnScheduleId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetBurnScheduleHistories() []BurnScheduleHistory {
+	if m != nil {
+		return m.BurnScheduleHistories
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "xpla.burn.v1beta1.GenesisState")
 }
@@ -77,22 +133,35 @@ func init() {
 func init() { proto.RegisterFile("xpla/burn/v1beta1/genesis.proto", fileDescriptor_a68487696cc80086) }
 
 var fileDescriptor_a68487696cc80086 = []byte{
-	// 234 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xaf, 0x28, 0xc8, 0x49,
-	0xd4, 0x4f, 0x2a, 0x2d, 0xca, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x2a, 0x24, 0x83, 0x69,
-	0x38, 0xd8, 0x20, 0xb0, 0xac, 0x52, 0x01, 0x17, 0x8f, 0x3b, 0xc4, 0xaa, 0xe0, 0x92, 0xc4, 0x92,
-	0x54, 0xa1, 0x04, 0x2e, 0xb1, 0xfc, 0xbc, 0xf4, 0xfc, 0xcc, 0xbc, 0xf4, 0x78, 0x90, 0xaa, 0xf8,
-	0x82, 0xa2, 0xfc, 0x82, 0xfc, 0xe2, 0xc4, 0x9c, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x6e, 0x23,
-	0x79, 0x3d, 0x0c, 0xa7, 0xe8, 0x39, 0x95, 0x16, 0xe5, 0x05, 0x40, 0xd5, 0x39, 0x71, 0x9e, 0xb8,
-	0x27, 0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0x11, 0xa8, 0x49, 0xc8, 0xf2, 0xc5, 0x4e,
-	0x76, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
-	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x92, 0x9e, 0x59, 0x92,
-	0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xb2, 0x25, 0x25, 0xb5, 0x0c, 0x4c, 0xeb, 0x57,
-	0x40, 0x9c, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xb8, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0x75, 0xf6, 0x70, 0x19, 0x35, 0x01, 0x00, 0x00,
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x86, 0x1b, 0x56, 0x8a, 0xc8, 0x06, 0xd2, 0xac, 0x0d, 0xc2, 0x84, 0xd2, 0x0a, 0x21, 0x54,
+	0x21, 0x61, 0xab, 0x20, 0x6e, 0x88, 0x43, 0x38, 0x00, 0xb7, 0xb2, 0xdd, 0xb8, 0x14, 0xa7, 0xb1,
+	0x52, 0x8b, 0xd6, 0x5f, 0x94, 0xcf, 0x99, 0xba, 0x7f, 0xc1, 0xcf, 0x40, 0x9c, 0xf8, 0x19, 0x3b,
+	0xee, 0xc8, 0x09, 0x50, 0x2b, 0xc1, 0xdf, 0x40, 0xfe, 0x62, 0x4a, 0xaa, 0x00, 0xbb, 0x34, 0x95,
+	0xdf, 0xd7, 0xef, 0xe3, 0xf7, 0xb3, 0xc3, 0xfe, 0xb2, 0x98, 0x4b, 0x91, 0x56, 0xa5, 0x11, 0xa7,
+	0xa3, 0x54, 0x59, 0x39, 0x12, 0xb9, 0x32, 0x0a, 0x35, 0xf2, 0xa2, 0x04, 0x0b, 0x6c, 0xdf, 0x19,
+	0xb8, 0x33, 0x70, 0x6f, 0x38, 0x3a, 0xc8, 0x21, 0x07, 0x52, 0x85, 0xfb, 0x57, 0x1b, 0x8f, 0xf6,
+	0xe5, 0x42, 0x1b, 0x10, 0xf4, 0xeb, 0x97, 0xe2, 0x29, 0xe0, 0x02, 0x50, 0xa4, 0x12, 0xd5, 0x26,
+	0x7e, 0x0a, 0xda, 0x78, 0xfd, 0x6e, 0x1b, 0x4e, 0x20, 0x52, 0xef, 0xfd, 0xe8, 0x86, 0x7b, 0x2f,
+	0xeb, 0xb3, 0x9c, 0x58, 0x69, 0x15, 0x7b, 0x17, 0xde, 0x02, 0x93, 0x83, 0x36, 0xf9, 0xc4, 0xd9,
+	0x26, 0x45, 0x09, 0x05, 0xa0, 0x9c, 0x63, 0x14, 0x0c, 0x76, 0x86, 0xbb, 0x8f, 0xfb, 0xbc, 0x75,
+	0x56, 0x9e, 0x54, 0xa5, 0x19, 0x7b, 0x5f, 0x72, 0xfd, 0xfc, 0x6b, 0xbf, 0xf3, 0xf1, 0xe7, 0xe7,
+	0x87, 0xc1, 0xf1, 0x81, 0x4f, 0x6a, 0xea, 0xc8, 0x30, 0xdc, 0xb3, 0x60, 0xe5, 0x9c, 0xf2, 0x55,
+	0x16, 0x5d, 0xa1, 0xdc, 0x3b, 0xbc, 0xee, 0xc1, 0x5d, 0x8f, 0x4d, 0xf2, 0x0b, 0xd0, 0x26, 0x79,
+	0xea, 0x12, 0x3f, 0x7d, 0xeb, 0x0f, 0x73, 0x6d, 0x67, 0x55, 0xca, 0xa7, 0xb0, 0x10, 0xbe, 0x74,
+	0xfd, 0x79, 0x84, 0xd9, 0x7b, 0x61, 0xcf, 0x0a, 0x85, 0xb4, 0x01, 0x6b, 0xfa, 0x2e, 0x51, 0x12,
+	0x82, 0xb0, 0x71, 0x78, 0x93, 0xea, 0xcc, 0x34, 0x5a, 0x28, 0xb5, 0xc2, 0x68, 0x87, 0xb0, 0xf1,
+	0x3f, 0xea, 0xbc, 0x22, 0xdf, 0x59, 0xb3, 0xcd, 0x8d, 0x74, 0xb3, 0xae, 0x15, 0xb2, 0x37, 0x3e,
+	0x11, 0xa7, 0x33, 0x95, 0x55, 0x73, 0x85, 0x51, 0xf7, 0xbf, 0x03, 0x3a, 0xf1, 0xbe, 0x56, 0xe4,
+	0x6f, 0x01, 0xd9, 0x28, 0x3c, 0x34, 0x6a, 0x69, 0x27, 0x5b, 0xb9, 0x13, 0x9d, 0x45, 0x57, 0x07,
+	0xc1, 0xb0, 0x7b, 0xcc, 0x9c, 0xd8, 0x8c, 0x7a, 0x9d, 0xb1, 0x67, 0x61, 0xaf, 0x90, 0xa5, 0x5c,
+	0x60, 0xd4, 0x1b, 0x04, 0x34, 0xc6, 0x36, 0x7d, 0x4c, 0x86, 0x26, 0xd7, 0xef, 0x61, 0x3a, 0xbc,
+	0xbd, 0xcd, 0xfa, 0x33, 0x9e, 0x6b, 0x54, 0xe6, 0xc1, 0x25, 0x65, 0xfe, 0x32, 0xa6, 0xc3, 0xb4,
+	0xa5, 0x6b, 0x85, 0xc9, 0xf3, 0xf3, 0x55, 0x1c, 0x5c, 0xac, 0xe2, 0xe0, 0xfb, 0x2a, 0x0e, 0x3e,
+	0xac, 0xe3, 0xce, 0xc5, 0x3a, 0xee, 0x7c, 0x59, 0xc7, 0x9d, 0xb7, 0xf7, 0x1b, 0xd7, 0xea, 0x68,
+	0x99, 0x3a, 0xa5, 0xaf, 0x58, 0xd6, 0xaf, 0x96, 0x2e, 0x36, 0xed, 0xd1, 0x7b, 0x7d, 0xf2, 0x2b,
+	0x00, 0x00, 0xff, 0xff, 0x2b, 0xc5, 0x34, 0xad, 0x4c, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +184,77 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BurnScheduleHistories) > 0 {
+		for iNdEx := len(m.BurnScheduleHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnScheduleHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.NextBurnScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextBurnScheduleId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BurnSchedules) > 0 {
+		for iNdEx := len(m.BurnSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BurnHistories) > 0 {
+		for iNdEx := len(m.BurnHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalBurned) > 0 {
+		for iNdEx := len(m.TotalBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.OngoingBurnProposals) > 0 {
 		for iNdEx := len(m.OngoingBurnProposals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -155,6 +295,35 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TotalBurned) > 0 {
+		for _, e := range m.TotalBurned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurnHistories) > 0 {
+		for _, e := range m.BurnHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurnSchedules) > 0 {
+		for _, e := range m.BurnSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextBurnScheduleId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextBurnScheduleId))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BurnScheduleHistories) > 0 {
+		for _, e := range m.BurnScheduleHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -227,6 +396,194 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurned = append(m.TotalBurned, types.Coin{})
+			if err := m.TotalBurned[len(m.TotalBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnHistories = append(m.BurnHistories, BurnHistory{})
+			if err := m.BurnHistories[len(m.BurnHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnSchedules = append(m.BurnSchedules, BurnSchedule{})
+			if err := m.BurnSchedules[len(m.BurnSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBurnScheduleId", wireType)
+			}
+			m.NextBurnScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextBurnScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnScheduleHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnScheduleHistories = append(m.BurnScheduleHistories, BurnScheduleHistory{})
+			if err := m.BurnScheduleHistories[len(m.BurnScheduleHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/xpladev/xpla/x/burn/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	proposer := sdk.AccAddress("proposer").String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("axpla", 100))
+	invalidAmount := sdk.Coins{sdk.Coin{Denom: "axpla", Amount: sdkmath.NewInt(-1)}}
+
+	proposal := types.BurnProposal{ProposalId: 1, Proposer: proposer, Amount: amount}
+	history := types.BurnHistory{ProposalId: 2, Height: 10, Amount: amount}
+	schedule := types.BurnSchedule{Id: 0, AmountPerBurn: amount, IntervalBlocks: 10, TotalAmount: amount, BurnedAmount: sdk.Coins{}, NextBurnHeight: 10}
+	scheduleHistory := types.BurnScheduleHistory{ScheduleId: 0, Height: 10, Amount: amount}
+
+	tests := []struct {
+		name    string
+		genesis *types.GenesisState
+		wantErr bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{"success", types.NewGenesisState([]types.BurnProposal{proposal}, amount, []types.BurnHistory{history}, []types.BurnSchedule{schedule}, 1, []types.BurnScheduleHistory{scheduleHistory}, types.DefaultParams()), false},
+		{"invalid params", types.NewGenesisState(nil, nil, nil, nil, 0, nil, types.Params{AllowedDenoms: []string{"axpla", "axpla"}}), true},
+		{"invalid proposal", types.NewGenesisState([]types.BurnProposal{{ProposalId: 0, Proposer: proposer, Amount: amount}}, nil, nil, nil, 0, nil, types.DefaultParams()), true},
+		{"invalid proposal amount", types.NewGenesisState([]types.BurnProposal{{ProposalId: 1, Proposer: proposer, Amount: invalidAmount}}, nil, nil, nil, 0, nil, types.DefaultParams()), true},
+		{"duplicate proposal id", types.NewGenesisState([]types.BurnProposal{proposal, proposal}, nil, nil, nil, 0, nil, types.DefaultParams()), true},
+		{"invalid total burned", types.NewGenesisState(nil, invalidAmount, nil, nil, 0, nil, types.DefaultParams()), true},
+		{"invalid history amount", types.NewGenesisState(nil, nil, []types.BurnHistory{{ProposalId: 2, Height: 10, Amount: invalidAmount}}, nil, 0, nil, types.DefaultParams()), true},
+		{"duplicate history id", types.NewGenesisState(nil, nil, []types.BurnHistory{history, history}, nil, 0, nil, types.DefaultParams()), true},
+		{"invalid schedule amount", types.NewGenesisState(nil, nil, nil, []types.BurnSchedule{{Id: 0, AmountPerBurn: invalidAmount, IntervalBlocks: 10, TotalAmount: amount}}, 1, nil, types.DefaultParams()), true},
+		{"duplicate schedule id", types.NewGenesisState(nil, nil, nil, []types.BurnSchedule{schedule, schedule}, 1, nil, types.DefaultParams()), true},
+		{"schedule id not lower than next id", types.NewGenesisState(nil, nil, nil, []types.BurnSchedule{schedule}, 0, nil, types.DefaultParams()), true},
+		{"invalid schedule history amount", types.NewGenesisState(nil, nil, nil, nil, 1, []types.BurnScheduleHistory{{ScheduleId: 0, Height: 10, Amount: sdk.Coins{}}}, types.DefaultParams()), true},
+		{"duplicate schedule history", types.NewGenesisState(nil, nil, nil, nil, 1, []types.BurnScheduleHistory{scheduleHistory, scheduleHistory}, types.DefaultParams()), true},
+		{"schedule history id not lower than next id", types.NewGenesisState(nil, nil, nil, nil, 0, []types.BurnScheduleHistory{scheduleHistory}, types.DefaultParams()), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.genesis.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -14,4 +14,11 @@ const (
 
 var (
 	OngoingBurnProposalsPrefix = collections.NewPrefix("on_going_burn_proposals")
+	TotalBurnedPrefix          = collections.NewPrefix("total_burned")
+	BurnHistoriesPrefix        = collections.NewPrefix("burn_histories")
+	BurnSchedulesPrefix        = collections.NewPrefix("burn_schedules")
+	BurnScheduleSeqPrefix      = collections.NewPrefix("burn_schedule_seq")
+	ParamsPrefix               = collections.NewPrefix("params")
+
+	BurnScheduleHistoriesPrefix = collections.NewPrefix("burn_schedule_histories")
 )
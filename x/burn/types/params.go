@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultAllowedDenom = "axpla"
+)
+
+// DefaultParams returns default burn parameters
+func DefaultParams() Params {
+	return Params{
+		AllowedDenoms:      []string{DefaultAllowedDenom},
+		MaxBurnAmounts:     sdk.Coins{},
+		MinProposerBalance: sdk.Coins{},
+	}
+}
+
+// Validate performs basic validation on burn parameters.
+func (p Params) Validate() error {
+	allowedDenoms := make(map[string]bool)
+	for _, denom := range p.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid allowed denom: %w", err)
+		}
+
+		if allowedDenoms[denom] {
+			return fmt.Errorf("duplicate allowed denom: %s", denom)
+		}
+		allowedDenoms[denom] = true
+	}
+
+	if err := p.MaxBurnAmounts.Validate(); err != nil {
+		return fmt.Errorf("invalid max burn amounts: %w", err)
+	}
+
+	for _, coin := range p.MaxBurnAmounts {
+		if !allowedDenoms[coin.Denom] {
+			return fmt.Errorf("max burn amount denom %s is not allowed", coin.Denom)
+		}
+	}
+
+	if err := p.MinProposerBalance.Validate(); err != nil {
+		return fmt.Errorf("invalid min proposer balance: %w", err)
+	}
+
+	return nil
+}
+
+// IsDenomAllowed returns true if the denom can be burned by governance
+func (p Params) IsDenomAllowed(denom string) bool {
+	for _, allowedDenom := range p.AllowedDenoms {
+		if allowedDenom == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateBurnAmount checks that the amount only contains allowed denoms
+// and does not exceed the max burn amount of each denom.
+func (p Params) ValidateBurnAmount(amount sdk.Coins) error {
+	for _, coin := range amount {
+		if !p.IsDenomAllowed(coin.Denom) {
+			return errorsmod.Wrapf(ErrDenomNotAllowed, "%s", coin.Denom)
+		}
+
+		if found, maxAmount := p.MaxBurnAmounts.Find(coin.Denom); found && coin.Amount.GT(maxAmount.Amount) {
+			return errorsmod.Wrapf(ErrInvalidBurnAmount, "%s exceeds max burn amount %s", coin, maxAmount)
+		}
+	}
+
+	return nil
+}
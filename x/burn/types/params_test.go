@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/xpladev/xpla/x/burn/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  types.Params
+		wantErr bool
+	}{
+		{"success", types.Params{[]string{"axpla", "stake"}, sdk.NewCoins(sdk.NewInt64Coin("axpla", 100)), sdk.NewCoins(sdk.NewInt64Coin("stake", 1))}, false},
+		{"no allowed denom", types.Params{[]string{}, sdk.Coins{}, sdk.Coins{}}, false},
+		{"invalid denom", types.Params{[]string{"1axpla"}, sdk.Coins{}, sdk.Coins{}}, true},
+		{"duplicate denom", types.Params{[]string{"axpla", "axpla"}, sdk.Coins{}, sdk.Coins{}}, true},
+		{"max burn amount of not allowed denom", types.Params{[]string{"axpla"}, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sdk.Coins{}}, true},
+		{"invalid max burn amount", types.Params{[]string{"axpla"}, sdk.Coins{sdk.Coin{Denom: "axpla", Amount: sdkmath.NewInt(-1)}}, sdk.Coins{}}, true},
+		{"invalid min proposer balance", types.Params{[]string{"axpla"}, sdk.Coins{}, sdk.Coins{sdk.Coin{Denom: "axpla", Amount: sdkmath.NewInt(-1)}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fields.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParams_ValidateBurnAmount(t *testing.T) {
+	params := types.Params{
+		AllowedDenoms:  []string{"axpla", "stake"},
+		MaxBurnAmounts: sdk.NewCoins(sdk.NewInt64Coin("axpla", 100)),
+	}
+
+	require.NoError(t, params.ValidateBurnAmount(sdk.NewCoins(sdk.NewInt64Coin("axpla", 100))))
+	require.NoError(t, params.ValidateBurnAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+	require.ErrorIs(t, params.ValidateBurnAmount(sdk.NewCoins(sdk.NewInt64Coin("axpla", 101))), types.ErrInvalidBurnAmount)
+	require.ErrorIs(t, params.ValidateBurnAmount(sdk.NewCoins(sdk.NewInt64Coin("ibc/ABCD", 1))), types.ErrDenomNotAllowed)
+}
+
+func TestDefaultParams(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+}
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -33,16 +34,105 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e1f598c4880bf1f, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e1f598c4880bf1f, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // QueryOngoingProposalsRequest is the request type for the
 // Query/OngoingProposals RPC method.
 type QueryOngoingProposalsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// proposer defines an optional filter by the proposer of the proposals.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// denom defines an optional filter by a denom of the burn amount.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryOngoingProposalsRequest) Reset()         { *m = QueryOngoingProposalsRequest{} }
 func (m *QueryOngoingProposalsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOngoingProposalsRequest) ProtoMessage()    {}
 func (*QueryOngoingProposalsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e1f598c4880bf1f, []int{0}
+	return fileDescriptor_6e1f598c4880bf1f, []int{2}
 }
 func (m *QueryOngoingProposalsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -71,17 +161,40 @@ func (m *QueryOngoingProposalsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryOngoingProposalsRequest proto.InternalMessageInfo
 
+func (m *QueryOngoingProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryOngoingProposalsRequest) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *QueryOngoingProposalsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // QueryOngoingProposalsResponse is the response type for the
 // Query/OngoingProposals RPC method.
 type QueryOngoingProposalsResponse struct {
 	Proposals []BurnProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryOngoingProposalsResponse) Reset()         { *m = QueryOngoingProposalsResponse{} }
 func (m *QueryOngoingProposalsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOngoingProposalsResponse) ProtoMessage()    {}
 func (*QueryOngoingProposalsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e1f598c4880bf1f, []int{1}
+	return fileDescriptor_6e1f598c4880bf1f, []int{3}
 }
 func (m *QueryOngoingProposalsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -117,6 +230,13 @@ func (m *QueryOngoingProposalsResponse) GetProposals() []BurnProposal {
 	return nil
 }
 
+func (m *QueryOngoingProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryOngoingProposalRequest is the request type for the Query/OngoingProposal
 // RPC method.
 type QueryOngoingProposalRequest struct {
@@ -127,7 +247,7 @@ func (m *QueryOngoingProposalRequest) Reset()         { *m = QueryOngoingProposa
 func (m *QueryOngoingProposalRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOngoingProposalRequest) ProtoMessage()    {}
 func (*QueryOngoingProposalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e1f598c4880bf1f, []int{2}
+	return fileDescriptor_6e1f598c4880bf1f, []int{4}
 }
 func (m *QueryOngoingProposalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,7 +294,7 @@ func (m *QueryOngoingProposalResponse) Reset()         { *m = QueryOngoingPropos
 func (m *QueryOngoingProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOngoingProposalResponse) ProtoMessage()    {}
 func (*QueryOngoingProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e1f598c4880bf1f, []int{3}
+	return fileDescriptor_6e1f598c4880bf1f, []int{5}
 }
 func (m *QueryOngoingProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -217,375 +337,2481 @@ func (m *QueryOngoingProposalResponse) GetAmount() github_com_cosmos_cosmos_sdk_
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryOngoingProposalsRequest)(nil), "xpla.burn.v1beta1.QueryOngoingProposalsRequest")
-	proto.RegisterType((*QueryOngoingProposalsResponse)(nil), "xpla.burn.v1beta1.QueryOngoingProposalsResponse")
-	proto.RegisterType((*QueryOngoingProposalRequest)(nil), "xpla.burn.v1beta1.QueryOngoingProposalRequest")
-	proto.RegisterType((*QueryOngoingProposalResponse)(nil), "xpla.burn.v1beta1.QueryOngoingProposalResponse")
+// QueryTotalBurnedRequest is the request type for the Query/TotalBurned RPC
+// method.
+type QueryTotalBurnedRequest struct {
 }
 
-func init() { proto.RegisterFile("xpla/burn/v1beta1/query.proto", fileDescriptor_6e1f598c4880bf1f) }
-
-var fileDescriptor_6e1f598c4880bf1f = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x85, 0x52, 0xd1, 0xeb, 0x00, 0x3d, 0x75, 0x48, 0x43, 0xeb, 0x54, 0xa6, 0xa0, 0x08,
-	0xa8, 0x8f, 0x16, 0x58, 0x2b, 0xe1, 0x4e, 0x4c, 0x80, 0xd9, 0x58, 0xa2, 0x73, 0x7c, 0x72, 0x4f,
-	0x24, 0xf7, 0x5c, 0xdf, 0xb9, 0x6a, 0x57, 0xfe, 0x02, 0x24, 0xfe, 0x00, 0x06, 0x16, 0xc4, 0xc4,
-	0xc0, 0xcc, 0xdc, 0x8d, 0x0a, 0x16, 0x26, 0x40, 0x09, 0x12, 0xff, 0x06, 0xf2, 0xdd, 0xd9, 0xa0,
-	0x26, 0x20, 0x2f, 0xfe, 0x71, 0xdf, 0xfb, 0xde, 0xfb, 0xbe, 0xef, 0xd9, 0x78, 0xe3, 0x38, 0x1b,
-	0x31, 0x1a, 0x17, 0xb9, 0xa4, 0x47, 0x3b, 0x31, 0xd7, 0x6c, 0x87, 0x1e, 0x16, 0x3c, 0x3f, 0x09,
-	0xb2, 0x1c, 0x34, 0x90, 0x95, 0x12, 0x0e, 0x4a, 0x38, 0x70, 0x70, 0x77, 0x35, 0x85, 0x14, 0x0c,
-	0x4a, 0xcb, 0x27, 0x5b, 0xd8, 0x5d, 0x4f, 0x01, 0xd2, 0x11, 0xa7, 0x2c, 0x13, 0x94, 0x49, 0x09,
-	0x9a, 0x69, 0x01, 0x52, 0x39, 0xd4, 0x1b, 0x82, 0x1a, 0x83, 0xa2, 0x31, 0x53, 0xbc, 0x9e, 0x33,
-	0x04, 0x21, 0x2b, 0xf6, 0xac, 0x0a, 0x33, 0xd3, 0xa2, 0x6b, 0x96, 0x3d, 0xb0, 0x43, 0xed, 0x8b,
-	0x83, 0x56, 0xd8, 0x58, 0x48, 0xa0, 0xe6, 0x6a, 0x8f, 0x7c, 0x0f, 0xaf, 0x3f, 0x29, 0x1d, 0x3c,
-	0x92, 0x29, 0x08, 0x99, 0x3e, 0xce, 0x21, 0x03, 0xc5, 0x46, 0x2a, 0xe2, 0x87, 0x05, 0x57, 0xda,
-	0x4f, 0xf0, 0xc6, 0x3f, 0x70, 0x95, 0x81, 0x54, 0x9c, 0xec, 0xe3, 0xa5, 0xac, 0x3a, 0xec, 0xa0,
-	0xcd, 0x0b, 0xfd, 0xe5, 0xdd, 0x5e, 0x30, 0x93, 0x43, 0x10, 0x16, 0xb9, 0xac, 0xc8, 0xe1, 0xc2,
-	0xe9, 0xb7, 0x5e, 0x2b, 0xfa, 0xc3, 0xf3, 0xf7, 0xf0, 0xd5, 0x79, 0x53, 0x9c, 0x08, 0xd2, 0xc3,
-	0xcb, 0x55, 0xed, 0x40, 0x24, 0x1d, 0xb4, 0x89, 0xfa, 0x0b, 0x11, 0xae, 0x8e, 0x1e, 0x26, 0xfe,
-	0x47, 0x34, 0xdf, 0x46, 0xad, 0xf2, 0x1e, 0xbe, 0x64, 0xcb, 0x79, 0x6e, 0xe8, 0x4b, 0x61, 0xe7,
-	0xf3, 0x87, 0xed, 0x55, 0x97, 0xce, 0x83, 0x24, 0xc9, 0xb9, 0x52, 0x4f, 0x75, 0x2e, 0x64, 0x1a,
-	0xd5, 0x95, 0xe4, 0x00, 0x2f, 0xb2, 0x31, 0x14, 0x52, 0x77, 0xda, 0xc6, 0xd8, 0x5a, 0xe0, 0x08,
-	0xe5, 0x66, 0x6a, 0x6b, 0xfb, 0x20, 0x64, 0x78, 0xbf, 0xb4, 0xf4, 0xee, 0x7b, 0xaf, 0x9f, 0x0a,
-	0x7d, 0x50, 0xc4, 0xc1, 0x10, 0xc6, 0x2e, 0x7b, 0x77, 0xdb, 0x56, 0xc9, 0x73, 0xaa, 0x4f, 0x32,
-	0xae, 0x0c, 0x41, 0xbd, 0xfd, 0xf5, 0xfe, 0x26, 0x8a, 0x5c, 0xff, 0xdd, 0x4f, 0x6d, 0x7c, 0xd1,
-	0x18, 0x20, 0x6f, 0x10, 0xbe, 0x72, 0x3e, 0x6c, 0x42, 0xe7, 0x24, 0xfa, 0xbf, 0xb5, 0x75, 0xef,
-	0x34, 0x27, 0xd8, 0x84, 0xfc, 0xdb, 0x2f, 0xbe, 0xfc, 0x7c, 0xd5, 0xbe, 0x41, 0xb6, 0xe8, 0xec,
-	0xd7, 0x05, 0x96, 0x34, 0xa8, 0x17, 0x46, 0x5e, 0x23, 0x7c, 0xf9, 0x5c, 0x2b, 0x12, 0x34, 0x9c,
-	0x59, 0x69, 0xa4, 0x8d, 0xeb, 0x9d, 0xc4, 0x5b, 0x46, 0xe2, 0x75, 0x72, 0xad, 0x81, 0xc4, 0x70,
-	0xef, 0x74, 0xe2, 0xa1, 0xb3, 0x89, 0x87, 0x7e, 0x4c, 0x3c, 0xf4, 0x72, 0xea, 0xb5, 0xce, 0xa6,
-	0x5e, 0xeb, 0xeb, 0xd4, 0x6b, 0x3d, 0xdb, 0xfa, 0x6b, 0x45, 0x65, 0xa3, 0x84, 0x1f, 0xd9, 0x86,
-	0xc7, 0xb6, 0xa5, 0x59, 0x52, 0xbc, 0x68, 0xfe, 0x8f, 0xbb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x1e, 0x6c, 0x62, 0xce, 0xf3, 0x03, 0x00, 0x00,
+func (m *QueryTotalBurnedRequest) Reset()         { *m = QueryTotalBurnedRequest{} }
+func (m *QueryTotalBurnedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBurnedRequest) ProtoMessage()    {}
+func (*QueryTotalBurnedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e1f598c4880bf1f, []int{6}
+}
+func (m *QueryTotalBurnedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalBurnedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalBurnedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalBurnedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBurnedRequest.Merge(m, src)
+}
+func (m *QueryTotalBurnedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalBurnedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBurnedRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryTotalBurnedRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryTotalBurnedResponse is the response type for the Query/TotalBurned RPC
+// method.
+type QueryTotalBurnedResponse struct {
+	TotalBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_burned,json=totalBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Query all ongoing burn proposals
-	OngoingProposals(ctx context.Context, in *QueryOngoingProposalsRequest, opts ...grpc.CallOption) (*QueryOngoingProposalsResponse, error)
-	// Query a specific ongoing burn proposal by ID
-	OngoingProposal(ctx context.Context, in *QueryOngoingProposalRequest, opts ...grpc.CallOption) (*QueryOngoingProposalResponse, error)
+func (m *QueryTotalBurnedResponse) Reset()         { *m = QueryTotalBurnedResponse{} }
+func (m *QueryTotalBurnedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBurnedResponse) ProtoMessage()    {}
+func (*QueryTotalBurnedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e1f598c4880bf1f, []int{7}
+}
+func (m *QueryTotalBurnedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalBurnedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalBurnedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalBurnedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBurnedResponse.Merge(m, src)
+}
+func (m *QueryTotalBurnedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalBurnedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBurnedResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryTotalBurnedResponse proto.InternalMessageInfo
+
+func (m *QueryTotalBurnedResponse) GetTotalBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBurned
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryBurnHistoryRequest is the request type for the Query/BurnHistory RPC
+// method.
+type QueryBurnHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) OngoingProposals(ctx context.Context, in *QueryOngoingProposalsRequest, opts ...grpc.CallOption) (*QueryOngoingProposalsResponse, error) {
-	out := new(QueryOngoingProposalsResponse)
-	err := c.cc.Invoke(ctx, "/xpla.burn.v1beta1.Query/OngoingProposals", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBurnHistoryRequest) Reset()         { *m = QueryBurnHistoryRequest{} }
+func (m *QueryBurnHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnHistoryRequest) ProtoMessage()    {}
+func (*QueryBurnHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e1f598c4880bf1f, []int{8}
+}
+func (m *QueryBurnHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBurnHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnHistoryRequest.Merge(m, src)
+}
+func (m *QueryBurnHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) OngoingProposal(ctx context.Context, in *QueryOngoingProposalRequest, opts ...grpc.CallOption) (*QueryOngoingProposalResponse, error) {
-	out := new(QueryOngoingProposalResponse)
-	err := c.cc.Invoke(ctx, "/xpla.burn.v1beta1.Query/OngoingProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBurnHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Query all ongoing burn proposals
-	OngoingProposals(context.Context, *QueryOngoingProposalsRequest) (*QueryOngoingProposalsResponse, error)
-	// Query a specific ongoing burn proposal by ID
-	OngoingProposal(context.Context, *QueryOngoingProposalRequest) (*QueryOngoingProposalResponse, error)
+// QueryBurnHistoryResponse is the response type for the Query/BurnHistory RPC
+// method.
+type QueryBurnHistoryResponse struct {
+	Histories []BurnHistory `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryBurnHistoryResponse) Reset()         { *m = QueryBurnHistoryResponse{} }
+func (m *QueryBurnHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnHistoryResponse) ProtoMessage()    {}
+func (*QueryBurnHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e1f598c4880bf1f, []int{9}
 }
-
-func (*UnimplementedQueryServer) OngoingProposals(ctx context.Context, req *QueryOngoingProposalsRequest) (*QueryOngoingProposalsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OngoingProposals not implemented")
+func (m *QueryBurnHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) OngoingProposal(ctx context.Context, req *QueryOngoingProposalRequest) (*QueryOngoingProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OngoingProposal not implemented")
+func (m *QueryBurnHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryBurnHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnHistoryResponse.Merge(m, src)
+}
+func (m *QueryBurnHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_OngoingProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOngoingProposalsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OngoingProposals(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xpla.burn.v1beta1.Query/OngoingProposals",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OngoingProposals(ctx, req.(*QueryOngoingProposalsRequest))
+var xxx_messageInfo_QueryBurnHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBurnHistoryResponse) GetHistories() []BurnHistory {
+	if m != nil {
+		return m.Histories
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_OngoingProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOngoingProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OngoingProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xpla.burn.v1beta1.Query/OngoingProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OngoingProposal(ctx, req.(*QueryOngoingProposalRequest))
+func (m *QueryBurnHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "xpla.burn.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "OngoingProposals",
-			Handler:    _Query_OngoingProposals_Handler,
-		},
-		{
-			MethodName: "OngoingProposal",
-			Handler:    _Query_OngoingProposal_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "xpla/burn/v1beta1/query.proto",
+// QueryBurnSchedulesRequest is the request type for the Query/BurnSchedules
+// RPC method.
+type QueryBurnSchedulesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOngoingProposalsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBurnSchedulesRequest) Reset()         { *m = QueryBurnSchedulesRequest{} }
+func (m *QueryBurnSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnSchedulesRequest) ProtoMessage()    {}
+func (*QueryBurnSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e1f598c4880bf1f, []int{10}
 }
-
-func (m *QueryOngoingProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryOngoingProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBurnSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnSchedulesRequest.Merge(m, src)
+}
+func (m *QueryBurnSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnSchedulesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOngoingProposalsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryBurnSchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOngoingProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBurnSchedulesResponse is the response type for the Query/BurnSchedules
+// RPC method.
+type QueryBurnSchedulesResponse struct {
+	Schedules []BurnSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOngoingProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proposals) > 0 {
-		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryBurnSchedulesResponse) Reset()         { *m = QueryBurnSchedulesResponse{} }
+func (m *QueryBurnSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnSchedulesResponse) ProtoMessage()    {}
+func (*QueryBurnSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e1f598c4880bf1f, []int{11}
+}
+func (m *QueryBurnSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBurnSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnSchedulesResponse.Merge(m, src)
+}
+func (m *QueryBurnSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnSchedulesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOngoingProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryBurnSchedulesResponse) GetSchedules() []BurnSchedule {
+	if m != nil {
+		return m.Schedules
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOngoingProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnSchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryOngoingProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+// QueryBurnScheduleHistoryRequest is the request type for the
+// Query/BurnScheduleHistory RPC method.
+type QueryBurnScheduleHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBurnScheduleHistoryRequest) Reset()         { *m = QueryBurnScheduleHistoryRequest{} }
+func (m *QueryBurnScheduleHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnScheduleHistoryRequest) ProtoMessage()    {}
+func (*QueryBurnScheduleHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e1f598c4880bf1f, []int{12}
+}
+func (m *QueryBurnScheduleHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnScheduleHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnScheduleHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBurnScheduleHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnScheduleHistoryRequest.Merge(m, src)
+}
+func (m *QueryBurnScheduleHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnScheduleHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnScheduleHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOngoingProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnScheduleHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBurnScheduleHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOngoingProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBurnScheduleHistoryResponse is the response type for the
+// Query/BurnScheduleHistory RPC method.
+type QueryBurnScheduleHistoryResponse struct {
+	Histories []BurnScheduleHistory `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOngoingProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryBurnScheduleHistoryResponse) Reset()         { *m = QueryBurnScheduleHistoryResponse{} }
+func (m *QueryBurnScheduleHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnScheduleHistoryResponse) ProtoMessage()    {}
+func (*QueryBurnScheduleHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e1f598c4880bf1f, []int{13}
+}
+func (m *QueryBurnScheduleHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnScheduleHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnScheduleHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBurnScheduleHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnScheduleHistoryResponse.Merge(m, src)
+}
+func (m *QueryBurnScheduleHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnScheduleHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnScheduleHistoryResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryBurnScheduleHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBurnScheduleHistoryResponse) GetHistories() []BurnScheduleHistory {
+	if m != nil {
+		return m.Histories
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryOngoingProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryBurnScheduleHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryOngoingProposalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "xpla.burn.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "xpla.burn.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryOngoingProposalsRequest)(nil), "xpla.burn.v1beta1.QueryOngoingProposalsRequest")
+	proto.RegisterType((*QueryOngoingProposalsResponse)(nil), "xpla.burn.v1beta1.QueryOngoingProposalsResponse")
+	proto.RegisterType((*QueryOngoingProposalRequest)(nil), "xpla.burn.v1beta1.QueryOngoingProposalRequest")
+	proto.RegisterType((*QueryOngoingProposalResponse)(nil), "xpla.burn.v1beta1.QueryOngoingProposalResponse")
+	proto.RegisterType((*QueryTotalBurnedRequest)(nil), "xpla.burn.v1beta1.QueryTotalBurnedRequest")
+	proto.RegisterType((*QueryTotalBurnedResponse)(nil), "xpla.burn.v1beta1.QueryTotalBurnedResponse")
+	proto.RegisterType((*QueryBurnHistoryRequest)(nil), "xpla.burn.v1beta1.QueryBurnHistoryRequest")
+	proto.RegisterType((*QueryBurnHistoryResponse)(nil), "xpla.burn.v1beta1.QueryBurnHistoryResponse")
+	proto.RegisterType((*QueryBurnSchedulesRequest)(nil), "xpla.burn.v1beta1.QueryBurnSchedulesRequest")
+	proto.RegisterType((*QueryBurnSchedulesResponse)(nil), "xpla.burn.v1beta1.QueryBurnSchedulesResponse")
+	proto.RegisterType((*QueryBurnScheduleHistoryRequest)(nil), "xpla.burn.v1beta1.QueryBurnScheduleHistoryRequest")
+	proto.RegisterType((*QueryBurnScheduleHistoryResponse)(nil), "xpla.burn.v1beta1.QueryBurnScheduleHistoryResponse")
+}
+
+func init() { proto.RegisterFile("xpla/burn/v1beta1/query.proto", fileDescriptor_6e1f598c4880bf1f) }
+
+var fileDescriptor_6e1f598c4880bf1f = []byte{
+	// 898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x33, 0xd9, 0xdd, 0x68, 0x33, 0x01, 0xc1, 0xce, 0x46, 0x22, 0xc9, 0xee, 0x3a, 0xa9,
+	0xdb, 0xa6, 0x21, 0x6d, 0xed, 0x36, 0x85, 0x1b, 0xaa, 0x44, 0x2a, 0xf1, 0xeb, 0x42, 0x49, 0x39,
+	0x71, 0x89, 0x9c, 0x78, 0xe4, 0x58, 0x24, 0x1e, 0xd7, 0xe3, 0x54, 0x2d, 0x47, 0xfe, 0x81, 0x22,
+	0x71, 0xe0, 0xc8, 0x81, 0x03, 0xa8, 0x12, 0x52, 0x85, 0x90, 0xb8, 0x20, 0xce, 0x3d, 0x56, 0x70,
+	0xe1, 0x04, 0xa8, 0x45, 0xe2, 0xdf, 0x40, 0x9e, 0x1f, 0x89, 0x13, 0xdb, 0x8d, 0x57, 0x4a, 0x2f,
+	0x6d, 0x3d, 0x6f, 0xde, 0x7b, 0x9f, 0xf7, 0xf5, 0xf3, 0x7b, 0x85, 0x2f, 0x4e, 0xdd, 0xa1, 0xa1,
+	0xf7, 0xc6, 0x9e, 0xa3, 0x9f, 0xec, 0xf6, 0xb0, 0x6f, 0xec, 0xea, 0xc7, 0x63, 0xec, 0x9d, 0x69,
+	0xae, 0x47, 0x7c, 0x82, 0x9e, 0x04, 0x66, 0x2d, 0x30, 0x6b, 0xc2, 0x5c, 0x29, 0x5a, 0xc4, 0x22,
+	0xcc, 0xaa, 0x07, 0x7f, 0xf1, 0x8b, 0x95, 0xe7, 0x16, 0x21, 0xd6, 0x10, 0xeb, 0x86, 0x6b, 0xeb,
+	0x86, 0xe3, 0x10, 0xdf, 0xf0, 0x6d, 0xe2, 0x50, 0x61, 0x55, 0xfa, 0x84, 0x8e, 0x08, 0xd5, 0x7b,
+	0x06, 0xc5, 0x93, 0x3c, 0x7d, 0x62, 0x3b, 0xc2, 0xde, 0x0c, 0xdb, 0x59, 0xfe, 0xc9, 0x2d, 0xd7,
+	0xb0, 0x6c, 0x87, 0x05, 0x93, 0x99, 0xa2, 0xc4, 0x8c, 0x8f, 0x5b, 0xcb, 0x3c, 0x52, 0x97, 0x03,
+	0xf2, 0x07, 0x61, 0x7a, 0x62, 0x8c, 0x6c, 0x87, 0xe8, 0xec, 0x27, 0x3f, 0x52, 0x8b, 0x10, 0x7d,
+	0x12, 0x64, 0x3b, 0x34, 0x3c, 0x63, 0x44, 0x3b, 0xf8, 0x78, 0x8c, 0xa9, 0xaf, 0x1e, 0xc1, 0xa7,
+	0x33, 0xa7, 0xd4, 0x25, 0x0e, 0xc5, 0xe8, 0x1d, 0x98, 0x73, 0xd9, 0x49, 0x09, 0xd4, 0x40, 0xa3,
+	0xd0, 0x2a, 0x6b, 0x11, 0x71, 0x34, 0xee, 0xd2, 0xce, 0x5f, 0xfd, 0x55, 0xcd, 0xfc, 0xf0, 0xdf,
+	0x65, 0x13, 0x74, 0x84, 0x8f, 0xfa, 0x13, 0x80, 0xcf, 0x59, 0xd4, 0x8f, 0x1d, 0x8b, 0xd8, 0x8e,
+	0x75, 0xe8, 0x11, 0x97, 0x50, 0x63, 0x28, 0xb3, 0xa2, 0xf7, 0x20, 0x9c, 0xd6, 0x2a, 0x52, 0xd4,
+	0x35, 0x51, 0x41, 0x20, 0x8c, 0xc6, 0x5f, 0xcc, 0x34, 0x95, 0x85, 0x85, 0x6f, 0x27, 0xe4, 0x89,
+	0xde, 0x82, 0x8f, 0x5d, 0x16, 0x1b, 0x7b, 0xa5, 0x6c, 0x0d, 0x34, 0xf2, 0xed, 0xd2, 0xef, 0x3f,
+	0x6f, 0x17, 0x45, 0xa0, 0x77, 0x4d, 0xd3, 0xc3, 0x94, 0x1e, 0xf9, 0x9e, 0xed, 0x58, 0x9d, 0xc9,
+	0x4d, 0x54, 0x84, 0x8f, 0x4c, 0xec, 0x90, 0x51, 0xe9, 0x41, 0xe0, 0xd2, 0xe1, 0x0f, 0xea, 0x8f,
+	0x00, 0xbe, 0x48, 0x80, 0x16, 0xa2, 0x1c, 0xc0, 0xbc, 0x2b, 0x0f, 0x4b, 0xa0, 0xf6, 0xa0, 0x51,
+	0x68, 0x55, 0x63, 0x74, 0x69, 0x8f, 0x3d, 0x47, 0x3a, 0xb7, 0x1f, 0x06, 0xea, 0x74, 0xa6, 0x7e,
+	0xe8, 0xfd, 0x99, 0xd2, 0xb3, 0xac, 0xf4, 0x8d, 0x85, 0xa5, 0x73, 0x82, 0x70, 0xed, 0xea, 0x3e,
+	0x7c, 0x16, 0x87, 0x2b, 0x25, 0xae, 0xc2, 0x82, 0x4c, 0xda, 0xb5, 0x4d, 0xa6, 0xf1, 0xc3, 0x0e,
+	0x94, 0x47, 0x1f, 0x9a, 0xea, 0x6f, 0x09, 0x2f, 0x69, 0x52, 0x6e, 0x58, 0x5c, 0x90, 0x5a, 0xdc,
+	0x01, 0xcc, 0x19, 0x23, 0x32, 0x76, 0xfc, 0x52, 0x96, 0x29, 0x54, 0x9e, 0xa9, 0x4d, 0x56, 0x75,
+	0x40, 0x6c, 0xa7, 0xfd, 0x76, 0xa0, 0xcd, 0xc5, 0xdf, 0xd5, 0x86, 0x65, 0xfb, 0x83, 0x71, 0x4f,
+	0xeb, 0x93, 0x91, 0xe8, 0x62, 0xf1, 0x6b, 0x9b, 0x9a, 0x9f, 0xeb, 0xfe, 0x99, 0x8b, 0x29, 0x73,
+	0xa0, 0xa2, 0xcb, 0x78, 0x7c, 0xb5, 0x0c, 0xdf, 0x60, 0xfc, 0x9f, 0x12, 0xdf, 0x18, 0x06, 0xa2,
+	0x63, 0x53, 0x76, 0xf5, 0x39, 0x80, 0xa5, 0xa8, 0x4d, 0xd4, 0x45, 0xe1, 0x2b, 0x7e, 0x70, 0xdc,
+	0xed, 0xb1, 0x73, 0xf1, 0x26, 0x97, 0xcf, 0x59, 0xf0, 0xa7, 0xc9, 0x55, 0x43, 0xc0, 0x06, 0x8f,
+	0x1f, 0xd8, 0xd4, 0x27, 0xde, 0xd9, 0x92, 0x3f, 0x06, 0xf5, 0x7b, 0x59, 0xf4, 0x4c, 0x0e, 0x51,
+	0x74, 0x1b, 0xe6, 0x07, 0xec, 0xc8, 0xc6, 0xb2, 0x77, 0x95, 0x84, 0xde, 0x15, 0xae, 0xb2, 0x75,
+	0x27, 0x6e, 0xcb, 0x6b, 0xdd, 0x3e, 0x2c, 0x4f, 0x40, 0x8f, 0xfa, 0x03, 0x6c, 0x8e, 0x87, 0x78,
+	0xd9, 0xb3, 0x41, 0xbd, 0x00, 0xb0, 0x12, 0x97, 0x65, 0xfa, 0x31, 0x53, 0x79, 0xb8, 0xe0, 0x63,
+	0x96, 0xce, 0x52, 0x91, 0x89, 0xdf, 0xf2, 0x14, 0xb1, 0x61, 0x35, 0xc2, 0x7a, 0x4f, 0x6d, 0xf2,
+	0x0b, 0x80, 0xb5, 0xe4, 0x5c, 0x42, 0x9d, 0x8f, 0xa2, 0xed, 0x52, 0x5f, 0xa0, 0xce, 0xbd, 0xb7,
+	0x4d, 0xeb, 0xd7, 0xc7, 0xf0, 0x11, 0x23, 0x47, 0x5f, 0xc0, 0x1c, 0xdf, 0x3e, 0x68, 0x3d, 0x86,
+	0x2a, 0xba, 0xe6, 0x2a, 0xf5, 0x45, 0xd7, 0x78, 0x3a, 0x75, 0xe5, 0xcb, 0x3f, 0xfe, 0xfd, 0x3a,
+	0xfb, 0x0c, 0x95, 0xf5, 0xe8, 0xe6, 0xe5, 0xcb, 0x0d, 0x7d, 0x07, 0xe0, 0xeb, 0xf3, 0x2b, 0x02,
+	0xe9, 0x49, 0xf1, 0x13, 0x36, 0x60, 0x65, 0x27, 0xbd, 0x83, 0x40, 0xdb, 0x62, 0x68, 0x75, 0xb4,
+	0x16, 0x83, 0x46, 0xb8, 0x53, 0x77, 0xba, 0x66, 0xbe, 0x05, 0xf0, 0xb5, 0xb9, 0x50, 0x48, 0x4b,
+	0x99, 0x53, 0x32, 0xea, 0xa9, 0xef, 0x0b, 0xc4, 0x4d, 0x86, 0xb8, 0x8e, 0x56, 0x53, 0x20, 0xa2,
+	0x73, 0x00, 0x0b, 0xa1, 0xf1, 0x8c, 0x9a, 0x49, 0xd9, 0xa2, 0xf3, 0xbd, 0xb2, 0x99, 0xea, 0xae,
+	0xa0, 0xda, 0x60, 0x54, 0x2b, 0xa8, 0x1a, 0x43, 0x15, 0x5e, 0x04, 0x8c, 0x28, 0x34, 0x00, 0x93,
+	0x89, 0xa2, 0x43, 0x3c, 0x99, 0x28, 0x66, 0x18, 0xdf, 0x49, 0x14, 0x3c, 0x74, 0x07, 0x82, 0xe0,
+	0x1b, 0x00, 0x5f, 0x9d, 0x19, 0x5f, 0x68, 0xeb, 0xae, 0x3c, 0xf3, 0xb3, 0xb4, 0xb2, 0x9d, 0xf2,
+	0xb6, 0xe0, 0x7a, 0x93, 0x71, 0xad, 0xa2, 0x95, 0x24, 0xae, 0xe9, 0xe4, 0xbb, 0x04, 0xf0, 0x69,
+	0xcc, 0xd7, 0x8f, 0x5a, 0x69, 0x32, 0xce, 0x69, 0xb7, 0xf7, 0x52, 0x3e, 0x82, 0x75, 0x87, 0xb1,
+	0x36, 0x51, 0x63, 0x11, 0xab, 0x14, 0xb3, 0xbd, 0x7f, 0x75, 0xa3, 0x80, 0xeb, 0x1b, 0x05, 0xfc,
+	0x73, 0xa3, 0x80, 0xaf, 0x6e, 0x95, 0xcc, 0xf5, 0xad, 0x92, 0xf9, 0xf3, 0x56, 0xc9, 0x7c, 0xb6,
+	0x16, 0x5a, 0xec, 0x41, 0x34, 0x13, 0x9f, 0xf0, 0xa8, 0xa7, 0x3c, 0x2e, 0x5b, 0xed, 0xbd, 0x1c,
+	0xfb, 0x3f, 0x7a, 0xef, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x75, 0x33, 0x99, 0x47, 0x0c,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the burn module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Query all ongoing burn proposals
+	OngoingProposals(ctx context.Context, in *QueryOngoingProposalsRequest, opts ...grpc.CallOption) (*QueryOngoingProposalsResponse, error)
+	// Query a specific ongoing burn proposal by ID
+	OngoingProposal(ctx context.Context, in *QueryOngoingProposalRequest, opts ...grpc.CallOption) (*QueryOngoingProposalResponse, error)
+	// Query the cumulative burned supply per denom
+	TotalBurned(ctx context.Context, in *QueryTotalBurnedRequest, opts ...grpc.CallOption) (*QueryTotalBurnedResponse, error)
+	// Query the burn history of executed burn proposals
+	BurnHistory(ctx context.Context, in *QueryBurnHistoryRequest, opts ...grpc.CallOption) (*QueryBurnHistoryResponse, error)
+	// Query all active burn schedules
+	BurnSchedules(ctx context.Context, in *QueryBurnSchedulesRequest, opts ...grpc.CallOption) (*QueryBurnSchedulesResponse, error)
+	// Query the burn history of executed burn schedules
+	BurnScheduleHistory(ctx context.Context, in *QueryBurnScheduleHistoryRequest, opts ...grpc.CallOption) (*QueryBurnScheduleHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/xpla.burn.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) OngoingProposals(ctx context.Context, in *QueryOngoingProposalsRequest, opts ...grpc.CallOption) (*QueryOngoingProposalsResponse, error) {
+	out := new(QueryOngoingProposalsResponse)
+	err := c.cc.Invoke(ctx, "/xpla.burn.v1beta1.Query/OngoingProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryOngoingProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) OngoingProposal(ctx context.Context, in *QueryOngoingProposalRequest, opts ...grpc.CallOption) (*QueryOngoingProposalResponse, error) {
+	out := new(QueryOngoingProposalResponse)
+	err := c.cc.Invoke(ctx, "/xpla.burn.v1beta1.Query/OngoingProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return out, nil
+}
+
+func (c *queryClient) TotalBurned(ctx context.Context, in *QueryTotalBurnedRequest, opts ...grpc.CallOption) (*QueryTotalBurnedResponse, error) {
+	out := new(QueryTotalBurnedResponse)
+	err := c.cc.Invoke(ctx, "/xpla.burn.v1beta1.Query/TotalBurned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnHistory(ctx context.Context, in *QueryBurnHistoryRequest, opts ...grpc.CallOption) (*QueryBurnHistoryResponse, error) {
+	out := new(QueryBurnHistoryResponse)
+	err := c.cc.Invoke(ctx, "/xpla.burn.v1beta1.Query/BurnHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnSchedules(ctx context.Context, in *QueryBurnSchedulesRequest, opts ...grpc.CallOption) (*QueryBurnSchedulesResponse, error) {
+	out := new(QueryBurnSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/xpla.burn.v1beta1.Query/BurnSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnScheduleHistory(ctx context.Context, in *QueryBurnScheduleHistoryRequest, opts ...grpc.CallOption) (*QueryBurnScheduleHistoryResponse, error) {
+	out := new(QueryBurnScheduleHistoryResponse)
+	err := c.cc.Invoke(ctx, "/xpla.burn.v1beta1.Query/BurnScheduleHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the burn module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Query all ongoing burn proposals
+	OngoingProposals(context.Context, *QueryOngoingProposalsRequest) (*QueryOngoingProposalsResponse, error)
+	// Query a specific ongoing burn proposal by ID
+	OngoingProposal(context.Context, *QueryOngoingProposalRequest) (*QueryOngoingProposalResponse, error)
+	// Query the cumulative burned supply per denom
+	TotalBurned(context.Context, *QueryTotalBurnedRequest) (*QueryTotalBurnedResponse, error)
+	// Query the burn history of executed burn proposals
+	BurnHistory(context.Context, *QueryBurnHistoryRequest) (*QueryBurnHistoryResponse, error)
+	// Query all active burn schedules
+	BurnSchedules(context.Context, *QueryBurnSchedulesRequest) (*QueryBurnSchedulesResponse, error)
+	// Query the burn history of executed burn schedules
+	BurnScheduleHistory(context.Context, *QueryBurnScheduleHistoryRequest) (*QueryBurnScheduleHistoryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) OngoingProposals(ctx context.Context, req *QueryOngoingProposalsRequest) (*QueryOngoingProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OngoingProposals not implemented")
+}
+func (*UnimplementedQueryServer) OngoingProposal(ctx context.Context, req *QueryOngoingProposalRequest) (*QueryOngoingProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OngoingProposal not implemented")
+}
+func (*UnimplementedQueryServer) TotalBurned(ctx context.Context, req *QueryTotalBurnedRequest) (*QueryTotalBurnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalBurned not implemented")
+}
+func (*UnimplementedQueryServer) BurnHistory(ctx context.Context, req *QueryBurnHistoryRequest) (*QueryBurnHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnHistory not implemented")
+}
+func (*UnimplementedQueryServer) BurnSchedules(ctx context.Context, req *QueryBurnSchedulesRequest) (*QueryBurnSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnSchedules not implemented")
+}
+func (*UnimplementedQueryServer) BurnScheduleHistory(ctx context.Context, req *QueryBurnScheduleHistoryRequest) (*QueryBurnScheduleHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnScheduleHistory not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.burn.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OngoingProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOngoingProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OngoingProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.burn.v1beta1.Query/OngoingProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OngoingProposals(ctx, req.(*QueryOngoingProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OngoingProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOngoingProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OngoingProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.burn.v1beta1.Query/OngoingProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OngoingProposal(ctx, req.(*QueryOngoingProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalBurned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalBurnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalBurned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.burn.v1beta1.Query/TotalBurned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalBurned(ctx, req.(*QueryTotalBurnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.burn.v1beta1.Query/BurnHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnHistory(ctx, req.(*QueryBurnHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.burn.v1beta1.Query/BurnSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnSchedules(ctx, req.(*QueryBurnSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnScheduleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnScheduleHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnScheduleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.burn.v1beta1.Query/BurnScheduleHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnScheduleHistory(ctx, req.(*QueryBurnScheduleHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "xpla.burn.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "OngoingProposals",
+			Handler:    _Query_OngoingProposals_Handler,
+		},
+		{
+			MethodName: "OngoingProposal",
+			Handler:    _Query_OngoingProposal_Handler,
+		},
+		{
+			MethodName: "TotalBurned",
+			Handler:    _Query_TotalBurned_Handler,
+		},
+		{
+			MethodName: "BurnHistory",
+			Handler:    _Query_BurnHistory_Handler,
+		},
+		{
+			MethodName: "BurnSchedules",
+			Handler:    _Query_BurnSchedules_Handler,
+		},
+		{
+			MethodName: "BurnScheduleHistory",
+			Handler:    _Query_BurnScheduleHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "xpla/burn/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOngoingProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOngoingProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOngoingProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOngoingProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOngoingProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOngoingProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOngoingProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOngoingProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOngoingProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOngoingProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOngoingProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOngoingProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBurnedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBurnedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBurnedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBurnedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBurnedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBurnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalBurned) > 0 {
+		for iNdEx := len(m.TotalBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Histories) > 0 {
+		for iNdEx := len(m.Histories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Histories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnScheduleHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnScheduleHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnScheduleHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnScheduleHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnScheduleHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnScheduleHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Histories) > 0 {
+		for iNdEx := len(m.Histories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Histories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOngoingProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOngoingProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOngoingProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryOngoingProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalBurnedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalBurnedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalBurned) > 0 {
+		for _, e := range m.TotalBurned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBurnHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Histories) > 0 {
+		for _, e := range m.Histories {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnScheduleHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnScheduleHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Histories) > 0 {
+		for _, e := range m.Histories {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOngoingProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOngoingProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOngoingProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOngoingProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOngoingProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOngoingProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, BurnProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOngoingProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOngoingProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOngoingProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOngoingProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOngoingProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOngoingProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalBurnedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalBurnedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalBurnedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalBurnedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalBurnedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalBurnedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurned = append(m.TotalBurned, types.Coin{})
+			if err := m.TotalBurned[len(m.TotalBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryOngoingProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryBurnHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Histories = append(m.Histories, BurnHistory{})
+			if err := m.Histories[len(m.Histories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryOngoingProposalsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnSchedulesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -608,12 +2834,48 @@ func (m *QueryOngoingProposalsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOngoingProposalsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnSchedulesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOngoingProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -635,7 +2897,7 @@ func (m *QueryOngoingProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOngoingProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnSchedulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -658,15 +2920,15 @@ func (m *QueryOngoingProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOngoingProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnSchedulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOngoingProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -693,8 +2955,44 @@ func (m *QueryOngoingProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, BurnProposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedules = append(m.Schedules, BurnSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -719,7 +3017,7 @@ func (m *QueryOngoingProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOngoingProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnScheduleHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -742,17 +3040,17 @@ func (m *QueryOngoingProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOngoingProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnScheduleHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOngoingProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnScheduleHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -762,11 +3060,28 @@ func (m *QueryOngoingProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -788,7 +3103,7 @@ func (m *QueryOngoingProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOngoingProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnScheduleHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -811,17 +3126,17 @@ func (m *QueryOngoingProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOngoingProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnScheduleHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOngoingProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnScheduleHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -831,27 +3146,29 @@ func (m *QueryOngoingProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.Histories = append(m.Histories, BurnScheduleHistory{})
+			if err := m.Histories[len(m.Histories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -878,8 +3195,10 @@ func (m *QueryOngoingProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
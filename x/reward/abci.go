@@ -3,8 +3,6 @@ package reward
 import (
 	"context"
 
-	sdkmath "cosmossdk.io/math"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -20,13 +18,66 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, bk types.BankKeeper, sk
 		return err
 	}
 
-	if params.RewardDistributeAccount == "" {
-		return nil
+	// retry the reserve rewards which failed to be sent in the previous
+	// blocks, even if the governance removed their distribution sources
+	reserved, err := k.RetryPendingReserves(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(params.DistributionSources) == 0 {
+		return k.RecordDistribution(ctx, types.DistributionRecord{
+			Height:        sdk.UnwrapSDKContext(ctx).BlockHeight(),
+			FeePool:       sdk.NewCoins(),
+			CommunityPool: sdk.NewCoins(),
+			Reserve:       reserved,
+			FeeCollector:  sdk.NewCoins(),
+		})
+	}
+
+	record := types.DistributionRecord{
+		Height:        sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		FeePool:       sdk.NewCoins(),
+		CommunityPool: sdk.NewCoins(),
+		Reserve:       reserved,
+	}
+
+	for _, source := range params.DistributionSources {
+		feePool, communityPool, reserved, err := distributeSource(ctx, k, bk, sk, dk, source)
+		if err != nil {
+			return err
+		}
+
+		record.FeePool = record.FeePool.Add(feePool...)
+		record.CommunityPool = record.CommunityPool.Add(communityPool...)
+		record.Reserve = record.Reserve.Add(reserved...)
+	}
+
+	balances, err := k.DripAmount(ctx, params, k.EligiblePoolBalances(ctx, params))
+	if err != nil {
+		return err
+	}
+
+	if !balances.IsZero() {
+		err = bk.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, balances)
+		if err != nil {
+			return err
+		}
 	}
+	record.FeeCollector = sdk.NewCoins(balances...)
+
+	return k.RecordDistribution(ctx, record)
+}
 
-	total := params.TotalRate()
+// distributeSource withdraws the staking rewards of the distribution source
+// account and splits them between the reward module account, the community
+// pool and the reserve account.
+func distributeSource(
+	ctx context.Context, k keeper.Keeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistributionKeeper, source types.DistributionSource,
+) (feePool, communityPool, reserved sdk.Coins, err error) {
+	total := source.TotalRate()
 
-	rewardDistributeAccount := sdk.MustAccAddressFromBech32(params.RewardDistributeAccount)
+	rewardDistributeAccount := sdk.MustAccAddressFromBech32(source.Account)
 
 	totalRewards := map[string]sdk.Coin{}
 
@@ -61,48 +112,34 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, bk types.BankKeeper, sk
 		return false
 	})
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
-	feePoolRewards := sdk.NewCoins()
-	communityPoolRewards := sdk.NewCoins()
-	reserveRewards := sdk.NewCoins()
+	var feePoolCoins, communityPoolCoins, reserveCoins []sdk.Coin
 
-	feePoolRate := params.FeePoolRate.Mul(total)
-	reserveRate := params.ReserveRate.Mul(total)
+	feePoolRate := source.FeePoolRate.Mul(total)
+	reserveRate := source.ReserveRate.Mul(total)
 	for denom, totalReward := range totalRewards {
 		feePoolReward := sdk.NewCoin(denom, feePoolRate.MulInt(totalReward.Amount).TruncateInt())
-		feePoolRewards = append(feePoolRewards, feePoolReward)
+		feePoolCoins = append(feePoolCoins, feePoolReward)
 
 		reserveReward := sdk.NewCoin(denom, reserveRate.MulInt(totalReward.Amount).TruncateInt())
-		reserveRewards = append(reserveRewards, reserveReward)
+		reserveCoins = append(reserveCoins, reserveReward)
 
 		communityPoolReward := sdk.NewCoin(denom, totalReward.Amount.Sub(feePoolReward.Amount).Sub(reserveReward.Amount))
-		communityPoolRewards = append(communityPoolRewards, communityPoolReward)
+		communityPoolCoins = append(communityPoolCoins, communityPoolReward)
 	}
 
+	// sort the coins built from the map and drop the zero amounts
+	feePoolRewards := sdk.NewCoins(feePoolCoins...)
+	communityPoolRewards := sdk.NewCoins(communityPoolCoins...)
+	reserveRewards := sdk.NewCoins(reserveCoins...)
+
 	// fee pool
 	if len(feePoolRewards) > 0 {
 		err = bk.SendCoinsFromAccountToModule(ctx, rewardDistributeAccount, types.ModuleName, feePoolRewards)
 		if err != nil {
-			return err
-		}
-
-	}
-	rewardAccount := k.GetRewardAccount(ctx)
-	balances := bk.GetAllBalances(ctx, rewardAccount.GetAddress())
-	blockPerYear, err := k.GetBlocksPerYear(ctx)
-	if err != nil {
-		return err
-	}
-	for index, balance := range balances {
-		balances[index].Amount = balance.Amount.Quo(sdkmath.NewInt(int64(blockPerYear)))
-	}
-
-	if !balances.IsZero() {
-		err = bk.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, balances)
-		if err != nil {
-			return err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -110,15 +147,19 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, bk types.BankKeeper, sk
 	if len(communityPoolRewards) > 0 {
 		err = dk.FundCommunityPool(ctx, communityPoolRewards, rewardDistributeAccount)
 		if err != nil {
-			return err
+			return nil, nil, nil, err
 		}
 	}
 
 	// reserve
-	if len(reserveRewards) > 0 && params.ReserveAccount != "" {
-		reserveAccount := sdk.MustAccAddressFromBech32(params.ReserveAccount)
-		bk.SendCoins(ctx, rewardDistributeAccount, reserveAccount, reserveRewards)
+	reserved = sdk.NewCoins()
+	if len(reserveRewards) > 0 && source.ReserveAccount != "" {
+		reserveAccount := sdk.MustAccAddressFromBech32(source.ReserveAccount)
+		reserved, err = k.SendReserve(ctx, rewardDistributeAccount, reserveAccount, reserveRewards)
+		if err != nil {
+			return nil, nil, nil, err
+		}
 	}
 
-	return nil
+	return feePoolRewards, communityPoolRewards, reserved, nil
 }
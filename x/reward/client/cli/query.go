@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -23,6 +24,9 @@ func GetQueryCmd() *cobra.Command {
 	rewardQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryDistributionHistory(),
+		GetCmdQueryPendingReserve(),
+		GetCmdQueryEmissionProjection(),
 	)
 
 	return rewardQueryCmd
@@ -88,3 +92,132 @@ $ %s query reward pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryDistributionHistory returns the command for fetching the latest
+// reward distribution records.
+func GetCmdQueryDistributionHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-history",
+		Args:  cobra.NoArgs,
+		Short: "Query the latest reward distribution records",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amounts sent to the reward pool, community pool, reserve
+account and fee collector at each of the latest blocks.
+
+Example:
+$ %s query reward distribution-history --reverse
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DistributionHistory(cmd.Context(), &types.QueryDistributionHistoryRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "distribution-history")
+	return cmd
+}
+
+// GetCmdQueryPendingReserve returns the command for fetching the reserve
+// rewards waiting to be sent to the reserve accounts.
+func GetCmdQueryPendingReserve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-reserve",
+		Args:  cobra.NoArgs,
+		Short: "Query the reserve rewards waiting to be sent to the reserve accounts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the reserve rewards which could not be sent to the reserve
+accounts. The transfers are retried every block.
+
+Example:
+$ %s query reward pending-reserve
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingReserve(cmd.Context(), &types.QueryPendingReserveRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-reserve")
+	return cmd
+}
+
+// GetCmdQueryEmissionProjection returns the command for fetching the amount
+// dripped from the reward pool to the fee collector over the next blocks.
+func GetCmdQueryEmissionProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission-projection [blocks]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the amount dripped from the reward pool over the next blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount dripped from the reward pool to the fee collector over
+the next blocks with the current drip mode, and the annual rate it implies
+against the bonded tokens. The blocks must not exceed the blocks per year.
+
+Example:
+$ %s query reward emission-projection 100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			blocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EmissionProjection(cmd.Context(), &types.QueryEmissionProjectionRequest{Blocks: blocks})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
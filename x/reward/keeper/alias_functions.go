@@ -28,3 +28,10 @@ func (k Keeper) PoolBalances(ctx context.Context) sdk.Coins {
 
 	return pool
 }
+
+// EligiblePoolBalances returns the reward pool balances of the fundable
+// denoms, which are dripped to the fee collector.
+func (k Keeper) EligiblePoolBalances(ctx context.Context, params types.Params) sdk.Coins {
+	eligible, _ := params.SplitFundableCoins(k.PoolBalances(ctx))
+	return eligible
+}
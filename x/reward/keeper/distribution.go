@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/xpladev/xpla/x/reward/types"
+)
+
+// RecordDistribution emits the distribution of a block and stores it in the
+// distribution history if the history is enabled.
+func (k Keeper) RecordDistribution(ctx context.Context, record types.DistributionRecord) error {
+	if record.IsEmpty() {
+		return nil
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventDistribution{
+		Height:        record.Height,
+		FeePool:       record.FeePool,
+		CommunityPool: record.CommunityPool,
+		Reserve:       record.Reserve,
+		FeeCollector:  record.FeeCollector,
+	}); err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.DistributionHistoryLimit == 0 {
+		return nil
+	}
+
+	if err := k.SetDistributionRecord(ctx, record); err != nil {
+		return err
+	}
+
+	return k.PruneDistributionRecords(ctx, params.DistributionHistoryLimit)
+}
+
+// SetDistributionRecord stores the distribution record of a block height
+func (k Keeper) SetDistributionRecord(ctx context.Context, record types.DistributionRecord) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.GetDistributionRecordKey(record.Height), bz)
+}
+
+// PruneDistributionRecords removes the oldest distribution records so that
+// at most limit records remain. Only the newest limit records and the stale
+// ones before them are visited, so the cost does not grow with the history.
+func (k Keeper) PruneDistributionRecords(ctx context.Context, limit uint64) error {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DistributionRecordKeyPrefix)
+
+	// find the oldest record to keep by walking back from the newest record
+	var oldestKept []byte
+	iterator := store.ReverseIterator(nil, nil)
+	for count := uint64(0); iterator.Valid() && count < limit; iterator.Next() {
+		oldestKept = iterator.Key()
+		count++
+	}
+	hasStale := iterator.Valid()
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+	if !hasStale {
+		return nil
+	}
+
+	// collect the stale keys and delete them after closing the iterator
+	var staleKeys [][]byte
+	iterator = store.Iterator(nil, oldestKept)
+	for ; iterator.Valid(); iterator.Next() {
+		staleKeys = append(staleKeys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	return nil
+}
+
+// GetAllDistributionRecords returns all stored distribution records ordered by height
+func (k Keeper) GetAllDistributionRecords(ctx context.Context) ([]types.DistributionRecord, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DistributionRecordKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.DistributionRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.DistributionRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/xpladev/xpla/x/reward/types"
+)
+
+// DripAmount returns the amount of the reward pool balances dripped to the fee
+// collector in the current block according to the drip mode of params.
+func (k Keeper) DripAmount(ctx context.Context, params types.Params, balances sdk.Coins) (sdk.Coins, error) {
+	switch params.DripMode {
+	case types.DripModeExponential:
+		blockPerYear, err := k.GetBlocksPerYear(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		drip := sdk.NewCoins()
+		for _, balance := range balances {
+			drip = drip.Add(sdk.NewCoin(balance.Denom, balance.Amount.Quo(sdkmath.NewInt(int64(blockPerYear)))))
+		}
+
+		return drip, nil
+
+	case types.DripModeLinear, types.DripModeTargetApr:
+		return k.projectFixedDrip(ctx, params, balances, 1)
+
+	default:
+		return nil, fmt.Errorf("invalid drip mode: %d", params.DripMode)
+	}
+}
+
+// ProjectEmission returns the amount of the reward pool balances dripped to the
+// fee collector over the next blocks according to the drip mode of params,
+// assuming that no more rewards are added to the reward pool.
+func (k Keeper) ProjectEmission(ctx context.Context, params types.Params, balances sdk.Coins, blocks uint64) (sdk.Coins, error) {
+	switch params.DripMode {
+	case types.DripModeExponential:
+		blockPerYear, err := k.GetBlocksPerYear(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// balance * (1 - (1 - 1/blocksPerYear)^blocks)
+		remainRate := sdkmath.LegacyOneDec().Sub(sdkmath.LegacyOneDec().QuoInt64(int64(blockPerYear))).Power(blocks)
+
+		emission := sdk.NewCoins()
+		for _, balance := range balances {
+			remain := remainRate.MulInt(balance.Amount).Ceil().TruncateInt()
+			emission = emission.Add(sdk.NewCoin(balance.Denom, balance.Amount.Sub(remain)))
+		}
+
+		return emission, nil
+
+	case types.DripModeLinear, types.DripModeTargetApr:
+		return k.projectFixedDrip(ctx, params, balances, blocks)
+
+	default:
+		return nil, fmt.Errorf("invalid drip mode: %d", params.DripMode)
+	}
+}
+
+// projectFixedDrip returns the amount dripped over the next blocks by the drip
+// modes which drip a fixed amount every block, capped at the balances.
+func (k Keeper) projectFixedDrip(ctx context.Context, params types.Params, balances sdk.Coins, blocks uint64) (sdk.Coins, error) {
+	var perBlock sdk.Coins
+	switch params.DripMode {
+	case types.DripModeLinear:
+		perBlock = params.LinearDripAmount
+
+	case types.DripModeTargetApr:
+		blockPerYear, err := k.GetBlocksPerYear(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		bondedTokens, err := k.stakingKeeper.TotalBondedTokens(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		amount := params.TargetApr.MulInt(bondedTokens).QuoInt64(int64(blockPerYear)).TruncateInt()
+		perBlock = sdk.NewCoins(sdk.NewCoin(bondDenom, amount))
+
+	default:
+		return nil, fmt.Errorf("invalid drip mode: %d", params.DripMode)
+	}
+
+	drip := sdk.NewCoins()
+	for _, amount := range perBlock {
+		total := amount.Amount.Mul(sdkmath.NewIntFromUint64(blocks))
+		drip = drip.Add(sdk.NewCoin(amount.Denom, sdkmath.MinInt(total, balances.AmountOf(amount.Denom))))
+	}
+
+	return drip, nil
+}
+
+// impliedApr returns the annual rate of the bond denom amount emitted over the
+// blocks against the bonded tokens.
+func (k Keeper) impliedApr(ctx context.Context, emission sdk.Coins, blocks uint64) (sdkmath.LegacyDec, error) {
+	blockPerYear, err := k.GetBlocksPerYear(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	bondedTokens, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	if !bondedTokens.IsPositive() {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+
+	return sdkmath.LegacyNewDecFromInt(emission.AmountOf(bondDenom)).
+		MulInt(sdkmath.NewIntFromUint64(blockPerYear)).
+		QuoInt(bondedTokens.Mul(sdkmath.NewIntFromUint64(blocks))), nil
+}
@@ -12,6 +12,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	if err != nil {
 		panic(fmt.Errorf("error setting params %s", err))
 	}
+
+	for _, record := range data.DistributionRecords {
+		if err := k.SetDistributionRecord(ctx, record); err != nil {
+			panic(fmt.Errorf("error setting distribution record %s", err))
+		}
+	}
+
+	for _, pendingReserve := range data.PendingReserves {
+		if err := k.SetPendingReserve(ctx, pendingReserve); err != nil {
+			panic(fmt.Errorf("error setting pending reserve %s", err))
+		}
+	}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
@@ -19,5 +31,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err != nil {
 		panic(fmt.Errorf("error getting params %s", err))
 	}
-	return types.NewGenesisState(params)
+
+	distributionRecords, err := k.GetAllDistributionRecords(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error getting distribution records %s", err))
+	}
+
+	pendingReserves, err := k.GetAllPendingReserves(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error getting pending reserves %s", err))
+	}
+
+	return types.NewGenesisState(params, distributionRecords, pendingReserves)
 }
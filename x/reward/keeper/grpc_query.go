@@ -3,7 +3,14 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/xpladev/xpla/x/reward/types"
 )
 
@@ -23,7 +30,104 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 // RewardPool queries the reward pool coins
 func (k Keeper) Pool(c context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	pool := k.PoolBalances(ctx)
+	eligible, ineligible := params.SplitFundableCoins(pool)
+
+	return &types.QueryPoolResponse{Pool: pool, EligiblePool: eligible, IneligiblePool: ineligible}, nil
+}
+
+// DistributionHistory queries the latest reward distribution records
+func (k Keeper) DistributionHistory(c context.Context, req *types.QueryDistributionHistoryRequest) (*types.QueryDistributionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DistributionRecordKeyPrefix)
+
+	var records []types.DistributionRecord
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var record types.DistributionRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDistributionHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// PendingReserve queries the reserve rewards waiting to be sent to the reserve accounts
+func (k Keeper) PendingReserve(c context.Context, req *types.QueryPendingReserveRequest) (*types.QueryPendingReserveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingReserveKeyPrefix)
+
+	var pendingReserves []types.PendingReserve
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var pendingReserve types.PendingReserve
+		if err := k.cdc.Unmarshal(value, &pendingReserve); err != nil {
+			return err
+		}
+
+		pendingReserves = append(pendingReserves, pendingReserve)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingReserveResponse{PendingReserves: pendingReserves, Pagination: pageRes}, nil
+}
+
+// EmissionProjection queries the amount dripped from the reward pool to the fee collector over the next blocks
+func (k Keeper) EmissionProjection(c context.Context, req *types.QueryEmissionProjectionRequest) (*types.QueryEmissionProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Blocks == 0 {
+		return nil, status.Error(codes.InvalidArgument, "blocks must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// bound the projection to a year of blocks
+	blockPerYear, err := k.GetBlocksPerYear(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Blocks > blockPerYear {
+		return nil, status.Errorf(codes.InvalidArgument, "blocks must not exceed the blocks per year: %d", blockPerYear)
+	}
+
+	amount, err := k.ProjectEmission(ctx, params, k.EligiblePoolBalances(ctx, params), req.Blocks)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	apr, err := k.impliedApr(ctx, amount, req.Blocks)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryPoolResponse{Pool: pool}, nil
+	return &types.QueryEmissionProjectionResponse{Amount: amount, Apr: apr}, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -37,6 +38,11 @@ func NewKeeper(
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
+	// ensure reserve escrow module account is set
+	if addr := ak.GetModuleAddress(types.ReserveEscrowName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ReserveEscrowName))
+	}
+
 	return Keeper{
 		storeService:  storeService,
 		cdc:           cdc,
@@ -64,9 +70,35 @@ func (k Keeper) Logger(ctx context.Context) log.Logger {
 // The amount is added to the reward pool account
 // An error is returned if the amount cannot be sent to the module account.
 func (k Keeper) FundRewardPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, others := params.SplitFundableCoins(amount); !others.IsZero() {
+		return errorsmod.Wrapf(types.ErrDenomNotFundable, "%s", others)
+	}
+
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// WithdrawRewardPool sends coins from the reward pool to the recipient, or to
+// the community pool if the recipient is empty.
+func (k Keeper) WithdrawRewardPool(ctx context.Context, amount sdk.Coins, recipient sdk.AccAddress) error {
+	if recipient.Empty() {
+		if err := k.distKeeper.FundCommunityPool(ctx, amount, k.GetRewardAccount(ctx).GetAddress()); err != nil {
+			return err
+		}
+	} else if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventWithdrawRewardPool{
+		Recipient: recipient.String(),
+		Amount:    amount,
+	})
+}
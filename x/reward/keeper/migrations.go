@@ -5,6 +5,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/xpladev/xpla/x/reward/exported"
 	v2 "github.com/xpladev/xpla/x/reward/migrations/v2"
+	v3 "github.com/xpladev/xpla/x/reward/migrations/v3"
+	v4 "github.com/xpladev/xpla/x/reward/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -26,3 +28,19 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
 	return v2.MigrateStore(ctx, store, m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates the x/reward module state from the consensus
+// version 2 to version 3. Specifically, it sets the drip parameters which
+// keep the exponential reward pool drip.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	return v3.MigrateStore(store, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates the x/reward module state from the consensus
+// version 3 to version 4. Specifically, it moves the reward distribute account
+// and its split rates to the distribution sources.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	return v4.MigrateStore(store, m.keeper.cdc)
+}
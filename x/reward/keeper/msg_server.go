@@ -60,3 +60,63 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// WithdrawRewardPool implements the gRPC MsgServer interface. After a successful governance vote
+// it withdraws coins from the reward pool only if the requested authority
+// is the Cosmos SDK governance module account
+func (k msgServer) WithdrawRewardPool(goCtx context.Context, req *types.MsgWithdrawRewardPool) (*types.MsgWithdrawRewardPoolResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	var recipient sdk.AccAddress
+	if req.Recipient != "" {
+		addr, err := sdk.AccAddressFromBech32(req.Recipient)
+		if err != nil {
+			return nil, err
+		}
+		recipient = addr
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.WithdrawRewardPool(ctx, req.Amount, recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawRewardPoolResponse{}, nil
+}
+
+// ResolvePendingReserve implements the gRPC MsgServer interface. After a successful governance vote
+// it sends a pending reserve out of the reserve escrow account only if the requested authority
+// is the Cosmos SDK governance module account
+func (k msgServer) ResolvePendingReserve(goCtx context.Context, req *types.MsgResolvePendingReserve) (*types.MsgResolvePendingReserveResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	reserveAccount, err := sdk.AccAddressFromBech32(req.ReserveAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipient sdk.AccAddress
+	if req.Recipient != "" {
+		addr, err := sdk.AccAddressFromBech32(req.Recipient)
+		if err != nil {
+			return nil, err
+		}
+		recipient = addr
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ResolvePendingReserve(ctx, account, reserveAccount, recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResolvePendingReserveResponse{}, nil
+}
@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/xpladev/xpla/x/reward/types"
+)
+
+// SendReserve sends the reserve rewards of a distribution source account to
+// its reserve account. If the transfer fails, the rewards are moved to the
+// reserve escrow account as a pending reserve and retried in the following
+// blocks. If they cannot be escrowed either, they stay in the distribution
+// source account.
+func (k Keeper) SendReserve(ctx context.Context, account, reserveAccount sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error) {
+	if amount.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	err := cacheSend(ctx, func(cacheCtx context.Context) error {
+		return k.bankKeeper.SendCoins(cacheCtx, account, reserveAccount, amount)
+	})
+	if err == nil {
+		return amount, nil
+	}
+
+	k.Logger(ctx).Error("failed to send reserve rewards", "account", account.String(), "reserve_account", reserveAccount.String(), "error", err)
+
+	escrowErr := cacheSend(ctx, func(cacheCtx context.Context) error {
+		return k.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, account, types.ReserveEscrowName, amount)
+	})
+	if escrowErr != nil {
+		k.Logger(ctx).Error("failed to escrow reserve rewards", "account", account.String(), "reserve_account", reserveAccount.String(), "error", escrowErr)
+		return sdk.NewCoins(), nil
+	}
+
+	pendingReserve, err2 := k.GetPendingReserve(ctx, account, reserveAccount)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	pendingReserve.Amount = pendingReserve.Amount.Add(amount...)
+	if err2 := k.SetPendingReserve(ctx, pendingReserve); err2 != nil {
+		return nil, err2
+	}
+
+	return sdk.NewCoins(), sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventReserveTransferFailed{
+		Account:        account.String(),
+		ReserveAccount: reserveAccount.String(),
+		Amount:         amount,
+		Reason:         err.Error(),
+	})
+}
+
+// RetryPendingReserves retries the transfers of the pending reserves which are
+// due from the reserve escrow account and returns the amount sent to the
+// reserve accounts. Each failed retry doubles the number of blocks until the
+// next one, up to MaxPendingReserveRetryInterval.
+func (k Keeper) RetryPendingReserves(ctx context.Context) (sdk.Coins, error) {
+	pendingReserves, err := k.GetAllPendingReserves(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	escrowAddress := k.authKeeper.GetModuleAddress(types.ReserveEscrowName)
+
+	reserved := sdk.NewCoins()
+	for _, pendingReserve := range pendingReserves {
+		if height < pendingReserve.NextRetryHeight {
+			continue
+		}
+
+		account := sdk.MustAccAddressFromBech32(pendingReserve.Account)
+		reserveAccount := sdk.MustAccAddressFromBech32(pendingReserve.ReserveAccount)
+
+		// use the same send path as SendReserve, so that a reserve account
+		// which could receive the rewards before can receive the retry
+		err := cacheSend(ctx, func(cacheCtx context.Context) error {
+			return k.bankKeeper.SendCoins(cacheCtx, escrowAddress, reserveAccount, pendingReserve.Amount)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to retry pending reserve", "account", account.String(), "reserve_account", reserveAccount.String(), "error", err)
+
+			pendingReserve.Retries++
+			pendingReserve.NextRetryHeight = height + retryInterval(pendingReserve.Retries)
+			if err := k.SetPendingReserve(ctx, pendingReserve); err != nil {
+				return nil, err
+			}
+
+			if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventReserveRetryFailed{
+				Account:         pendingReserve.Account,
+				ReserveAccount:  pendingReserve.ReserveAccount,
+				Amount:          pendingReserve.Amount,
+				Reason:          err.Error(),
+				NextRetryHeight: pendingReserve.NextRetryHeight,
+			}); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := k.DeletePendingReserve(ctx, account, reserveAccount); err != nil {
+			return nil, err
+		}
+
+		reserved = reserved.Add(pendingReserve.Amount...)
+	}
+
+	return reserved, nil
+}
+
+// ResolvePendingReserve sends a pending reserve from the reserve escrow
+// account to the recipient, or to the community pool if the recipient is
+// empty, and removes it.
+func (k Keeper) ResolvePendingReserve(ctx context.Context, account, reserveAccount, recipient sdk.AccAddress) error {
+	pendingReserve, err := k.GetPendingReserve(ctx, account, reserveAccount)
+	if err != nil {
+		return err
+	}
+	if pendingReserve.Amount.IsZero() {
+		return errorsmod.Wrapf(types.ErrPendingReserveNotFound, "%s to %s", account, reserveAccount)
+	}
+
+	if recipient.Empty() {
+		if err := k.distKeeper.FundCommunityPool(ctx, pendingReserve.Amount, k.authKeeper.GetModuleAddress(types.ReserveEscrowName)); err != nil {
+			return err
+		}
+	} else if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ReserveEscrowName, recipient, pendingReserve.Amount); err != nil {
+		return err
+	}
+
+	if err := k.DeletePendingReserve(ctx, account, reserveAccount); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPendingReserveResolved{
+		Account:        pendingReserve.Account,
+		ReserveAccount: pendingReserve.ReserveAccount,
+		Recipient:      recipient.String(),
+		Amount:         pendingReserve.Amount,
+	})
+}
+
+// retryInterval returns the number of blocks until the next retry after the
+// given number of failed retries.
+func retryInterval(retries uint64) int64 {
+	if retries >= 62 {
+		return types.MaxPendingReserveRetryInterval
+	}
+
+	return min(int64(1)<<retries, types.MaxPendingReserveRetryInterval)
+}
+
+// cacheSend runs the transfer in a cache context so that a failed transfer
+// does not leave a partial state change.
+func cacheSend(ctx context.Context, send func(cacheCtx context.Context) error) error {
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if err := send(cacheCtx); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+// GetPendingReserve returns the pending reserve of a distribution source
+// account and its reserve account. An empty pending reserve is returned if it
+// does not exist.
+func (k Keeper) GetPendingReserve(ctx context.Context, account, reserveAccount sdk.AccAddress) (types.PendingReserve, error) {
+	pendingReserve := types.PendingReserve{
+		Account:        account.String(),
+		ReserveAccount: reserveAccount.String(),
+		Amount:         sdk.NewCoins(),
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetPendingReserveKey(account, reserveAccount))
+	if err != nil || bz == nil {
+		return pendingReserve, err
+	}
+
+	err = k.cdc.Unmarshal(bz, &pendingReserve)
+	return pendingReserve, err
+}
+
+// SetPendingReserve stores the pending reserve of a distribution source account
+func (k Keeper) SetPendingReserve(ctx context.Context, pendingReserve types.PendingReserve) error {
+	account, err := sdk.AccAddressFromBech32(pendingReserve.Account)
+	if err != nil {
+		return err
+	}
+
+	reserveAccount, err := sdk.AccAddressFromBech32(pendingReserve.ReserveAccount)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&pendingReserve)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.GetPendingReserveKey(account, reserveAccount), bz)
+}
+
+// DeletePendingReserve removes the pending reserve of a distribution source account
+func (k Keeper) DeletePendingReserve(ctx context.Context, account, reserveAccount sdk.AccAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetPendingReserveKey(account, reserveAccount))
+}
+
+// GetAllPendingReserves returns all pending reserves
+func (k Keeper) GetAllPendingReserves(ctx context.Context) ([]types.PendingReserve, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingReserveKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	pendingReserves := []types.PendingReserve{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingReserve types.PendingReserve
+		if err := k.cdc.Unmarshal(iterator.Value(), &pendingReserve); err != nil {
+			return nil, err
+		}
+		pendingReserves = append(pendingReserves, pendingReserve)
+	}
+
+	return pendingReserves, nil
+}
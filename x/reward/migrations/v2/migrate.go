@@ -1,6 +1,9 @@
 package v2
 
 import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,7 +15,7 @@ func MigrateStore(ctx sdk.Context, store storetypes.KVStore, legacySubspace expo
 	var currParams types.Params
 	legacySubspace.GetParamSet(ctx, &currParams)
 
-	if err := currParams.ValidateBasic(); err != nil {
+	if err := validateParams(currParams); err != nil {
 		return err
 	}
 
@@ -25,3 +28,38 @@ func MigrateStore(ctx sdk.Context, store storetypes.KVStore, legacySubspace expo
 
 	return nil
 }
+
+// validateParams performs the basic validation of the reward parameters as
+// they were defined at the consensus version 2.
+func validateParams(p types.Params) error {
+	if p.ReserveAccount == "" && p.ReserveRate.GT(sdkmath.LegacyZeroDec()) {
+		return fmt.Errorf("reserve account must be set up for reserve compensation")
+	}
+
+	if p.CommunityPoolRate.IsNegative() {
+		return fmt.Errorf(
+			"community pool rate should be positive: %s", p.CommunityPoolRate,
+		)
+	}
+
+	if p.FeePoolRate.IsNegative() {
+		return fmt.Errorf(
+			"fee pool rate should be positive: %s", p.FeePoolRate,
+		)
+	}
+
+	if p.ReserveRate.IsNegative() {
+		return fmt.Errorf(
+			"reserve rate should be positive: %s", p.ReserveRate,
+		)
+	}
+
+	totalRate := p.CommunityPoolRate.Add(p.FeePoolRate).Add(p.ReserveRate)
+	if totalRate.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf(
+			"sum of fee pool, community pool and reserve cannot be greater than one: %s", totalRate,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/xpladev/xpla/x/reward/types"
+)
+
+// MigrateStore sets the drip parameters of the x/reward module. The existing
+// reward pool drip is kept by using the exponential drip mode.
+func MigrateStore(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var currParams types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &currParams); err != nil {
+			return err
+		}
+	}
+
+	currParams.DripMode = types.DefaultDripMode
+	currParams.LinearDripAmount = types.DefaultLinearDripAmount
+	currParams.TargetApr = types.DefaultTargetApr
+
+	bz, err := cdc.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
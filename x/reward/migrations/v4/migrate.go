@@ -0,0 +1,49 @@
+package v4
+
+import (
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/xpladev/xpla/x/reward/types"
+)
+
+// MigrateStore moves the legacy reward distribute account and its split rates
+// of the x/reward module parameters to the distribution sources.
+func MigrateStore(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var currParams types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &currParams); err != nil {
+			return err
+		}
+	}
+
+	currParams.DistributionSources = []types.DistributionSource{}
+	if currParams.RewardDistributeAccount != "" {
+		currParams.DistributionSources = append(currParams.DistributionSources, types.NewDistributionSource(
+			currParams.RewardDistributeAccount,
+			currParams.FeePoolRate,
+			currParams.CommunityPoolRate,
+			currParams.ReserveRate,
+			currParams.ReserveAccount,
+		))
+	}
+
+	currParams.FeePoolRate = sdkmath.LegacyZeroDec()
+	currParams.CommunityPoolRate = sdkmath.LegacyZeroDec()
+	currParams.ReserveRate = sdkmath.LegacyZeroDec()
+	currParams.ReserveAccount = ""
+	currParams.RewardDistributeAccount = ""
+
+	if err := currParams.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
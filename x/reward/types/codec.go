@@ -11,6 +11,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgFundRewardPool{}, "xpladev/MsgFundRewardPool")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "xpladev/x/reward/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawRewardPool{}, "xpladev/x/reward/MsgWithdrawRewardPool")
+	legacy.RegisterAminoMsg(cdc, &MsgResolvePendingReserve{}, "xpladev/MsgResolvePendingReserve")
 
 	cdc.RegisterConcrete(Params{}, "xpladev/x/reward/Params", nil)
 }
@@ -20,6 +22,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgFundRewardPool{},
 		&MsgUpdateParams{},
+		&MsgWithdrawRewardPool{},
+		&MsgResolvePendingReserve{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
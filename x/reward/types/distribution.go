@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs basic validation of a distribution record
+func (r DistributionRecord) Validate() error {
+	if r.Height < 0 {
+		return fmt.Errorf("distribution record height must not be negative: %d", r.Height)
+	}
+
+	if err := r.FeePool.Validate(); err != nil {
+		return fmt.Errorf("invalid fee pool amount at height %d: %w", r.Height, err)
+	}
+
+	if err := r.CommunityPool.Validate(); err != nil {
+		return fmt.Errorf("invalid community pool amount at height %d: %w", r.Height, err)
+	}
+
+	if err := r.Reserve.Validate(); err != nil {
+		return fmt.Errorf("invalid reserve amount at height %d: %w", r.Height, err)
+	}
+
+	if err := r.FeeCollector.Validate(); err != nil {
+		return fmt.Errorf("invalid fee collector amount at height %d: %w", r.Height, err)
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if nothing was distributed
+func (r DistributionRecord) IsEmpty() bool {
+	return r.FeePool.IsZero() && r.CommunityPool.IsZero() && r.Reserve.IsZero() && r.FeeCollector.IsZero()
+}
+
+// Validate performs basic validation of a pending reserve
+func (r PendingReserve) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Account); err != nil {
+		return fmt.Errorf("invalid pending reserve account: %w", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.ReserveAccount); err != nil {
+		return fmt.Errorf("invalid pending reserve account %s: %w", r.Account, err)
+	}
+
+	if err := r.Amount.Validate(); err != nil || r.Amount.IsZero() {
+		return fmt.Errorf("invalid pending reserve amount of %s: %s", r.Account, r.Amount)
+	}
+
+	if r.NextRetryHeight < 0 {
+		return fmt.Errorf("invalid pending reserve next retry height of %s: %d", r.Account, r.NextRetryHeight)
+	}
+
+	return nil
+}
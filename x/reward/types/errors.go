@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/reward module sentinel errors
+var (
+	ErrDenomNotFundable       = errorsmod.Register(ModuleName, 2, "denom not fundable to the reward pool")
+	ErrPendingReserveNotFound = errorsmod.Register(ModuleName, 3, "pending reserve not found")
+)
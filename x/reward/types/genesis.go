@@ -1,17 +1,58 @@
 package types
 
-func NewGenesisState(params Params) *GenesisState {
+import (
+	"fmt"
+)
+
+func NewGenesisState(params Params, distributionRecords []DistributionRecord, pendingReserves []PendingReserve) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:              params,
+		DistributionRecords: distributionRecords,
+		PendingReserves:     pendingReserves,
 	}
 }
 
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:              DefaultParams(),
+		DistributionRecords: []DistributionRecord{},
+		PendingReserves:     []PendingReserve{},
 	}
 }
 
 func ValidateGenesis(gs *GenesisState) error {
-	return gs.Params.ValidateBasic()
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if uint64(len(gs.DistributionRecords)) > gs.Params.DistributionHistoryLimit {
+		return fmt.Errorf("distribution records exceed the distribution history limit: %d > %d", len(gs.DistributionRecords), gs.Params.DistributionHistoryLimit)
+	}
+
+	heights := make(map[int64]bool)
+	for _, record := range gs.DistributionRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if heights[record.Height] {
+			return fmt.Errorf("duplicate distribution record height: %d", record.Height)
+		}
+		heights[record.Height] = true
+	}
+
+	pendingReserves := make(map[string]bool)
+	for _, pendingReserve := range gs.PendingReserves {
+		if err := pendingReserve.Validate(); err != nil {
+			return err
+		}
+
+		key := pendingReserve.Account + "/" + pendingReserve.ReserveAccount
+		if pendingReserves[key] {
+			return fmt.Errorf("duplicate pending reserve: %s to %s", pendingReserve.Account, pendingReserve.ReserveAccount)
+		}
+		pendingReserves[key] = true
+	}
+
+	return nil
 }
@@ -28,6 +28,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the paramaters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	// distribution_records defines the latest distribution records.
+	DistributionRecords []DistributionRecord `protobuf:"bytes,2,rep,name=distribution_records,json=distributionRecords,proto3" json:"distribution_records"`
+	// pending_reserves defines the reserve rewards waiting to be sent to the
+	// reserve accounts.
+	PendingReserves []PendingReserve `protobuf:"bytes,3,rep,name=pending_reserves,json=pendingReserves,proto3" json:"pending_reserves"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +75,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetDistributionRecords() []DistributionRecord {
+	if m != nil {
+		return m.DistributionRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingReserves() []PendingReserve {
+	if m != nil {
+		return m.PendingReserves
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "xpla.reward.v1beta1.GenesisState")
 }
@@ -77,22 +96,28 @@ func init() {
 func init() { proto.RegisterFile("xpla/reward/v1beta1/genesis.proto", fileDescriptor_9d54be90a91914e8) }
 
 var fileDescriptor_9d54be90a91914e8 = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
+	// 321 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xac, 0x28, 0xc8, 0x49,
 	0xd4, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
 	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06,
 	0x29, 0xd1, 0x83, 0x28, 0xd1, 0x83, 0x2a, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
 	0x83, 0x58, 0x10, 0xa5, 0x52, 0x0a, 0xd8, 0x4c, 0x83, 0xea, 0x84, 0xa8, 0x10, 0x4c, 0xcc, 0xcd,
-	0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x21, 0xa5, 0x78, 0x2e, 0x1e, 0x77, 0x88, 0x85, 0xc1, 0x25,
-	0x89, 0x25, 0xa9, 0x42, 0xfe, 0x5c, 0x6c, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0xdc, 0x46, 0xd2, 0x7a, 0x58, 0x1c, 0xa0, 0x17, 0x00, 0x56, 0xe2, 0x24, 0x75, 0xe2,
-	0x9e, 0x3c, 0xc3, 0xa7, 0x7b, 0xf2, 0xbc, 0x95, 0x89, 0xb9, 0x39, 0x56, 0x4a, 0x10, 0x8d, 0x4a,
-	0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82, 0x1a, 0xe3, 0xe4, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85,
-	0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9,
-	0xfa, 0x20, 0x4b, 0x52, 0x52, 0xcb, 0xc0, 0xb4, 0x7e, 0x05, 0xcc, 0x13, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0x60, 0x97, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x0d, 0xe3, 0x93,
-	0x2e, 0x01, 0x00, 0x00,
+	0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x21, 0xa5, 0x85, 0x4c, 0x5c, 0x3c, 0xee, 0x10, 0x1b, 0x83,
+	0x4b, 0x12, 0x4b, 0x52, 0x85, 0xfc, 0xb9, 0xd8, 0x0a, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x25, 0x18,
+	0x15, 0x18, 0x35, 0xb8, 0x8d, 0xa4, 0xf5, 0xb0, 0xb8, 0x40, 0x2f, 0x00, 0xac, 0xc4, 0x49, 0xea,
+	0xc4, 0x3d, 0x79, 0x86, 0x4f, 0xf7, 0xe4, 0x79, 0x2b, 0x13, 0x73, 0x73, 0xac, 0x94, 0x20, 0x1a,
+	0x95, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0x35, 0x46, 0x28, 0x95, 0x4b, 0x24, 0x25, 0xb3,
+	0xb8, 0xa4, 0x28, 0x33, 0xa9, 0xb4, 0x24, 0x33, 0x3f, 0x2f, 0xbe, 0x28, 0x35, 0x39, 0xbf, 0x28,
+	0xa5, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x1d, 0xab, 0xf1, 0x2e, 0x48, 0x1a, 0x82,
+	0xc0, 0xea, 0x9d, 0x38, 0x41, 0x56, 0x41, 0x4c, 0x16, 0x4e, 0xc1, 0x90, 0x2e, 0x16, 0x8a, 0xe4,
+	0x12, 0x28, 0x48, 0xcd, 0x4b, 0xc9, 0xcc, 0x4b, 0x8f, 0x2f, 0x4a, 0x2d, 0x4e, 0x2d, 0x2a, 0x4b,
+	0x2d, 0x96, 0x60, 0x06, 0x5b, 0xa1, 0x8c, 0xdd, 0x07, 0x10, 0xc5, 0x41, 0x10, 0xb5, 0xc8, 0xc6,
+	0xf3, 0x17, 0xa0, 0x48, 0x15, 0x3b, 0x39, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
+	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
+	0x43, 0x94, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xc8, 0x92,
+	0x94, 0xd4, 0x32, 0x30, 0xad, 0x5f, 0x01, 0x8b, 0x87, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
+	0x70, 0x60, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x44, 0x4a, 0x08, 0x69, 0xf1, 0x01, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +140,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingReserves) > 0 {
+		for iNdEx := len(m.PendingReserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DistributionRecords) > 0 {
+		for iNdEx := len(m.DistributionRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -147,6 +200,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DistributionRecords) > 0 {
+		for _, e := range m.DistributionRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingReserves) > 0 {
+		for _, e := range m.PendingReserves {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -218,6 +283,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionRecords = append(m.DistributionRecords, DistributionRecord{})
+			if err := m.DistributionRecords[len(m.DistributionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingReserves = append(m.PendingReserves, PendingReserve{})
+			if err := m.PendingReserves[len(m.PendingReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
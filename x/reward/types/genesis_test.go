@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/xpladev/xpla/x/reward/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(types.DefaultGenesisState()))
+
+	params := types.DefaultParams()
+	params.DistributionHistoryLimit = 2
+
+	record := types.DistributionRecord{
+		Height:  1,
+		FeePool: sdk.NewCoins(sdk.NewCoin("axpla", sdkmath.NewInt(1))),
+	}
+
+	tests := []struct {
+		name    string
+		records []types.DistributionRecord
+		wantErr bool
+	}{
+		{"success", []types.DistributionRecord{record, {Height: 2}}, false},
+		{"duplicate height", []types.DistributionRecord{record, record}, true},
+		{"exceed limit", []types.DistributionRecord{record, {Height: 2}, {Height: 3}}, true},
+		{"negative height", []types.DistributionRecord{{Height: -1}}, true},
+		{"invalid coins", []types.DistributionRecord{{Height: 1, Reserve: sdk.Coins{sdk.Coin{Denom: "axpla", Amount: sdkmath.NewInt(-1)}}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidateGenesis(types.NewGenesisState(params, tt.records, nil))
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestValidateGenesisPendingReserves(t *testing.T) {
+	account := "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a"
+	reserveAccount := "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+	pendingReserve := types.PendingReserve{
+		Account:        account,
+		ReserveAccount: reserveAccount,
+		Amount:         sdk.NewCoins(sdk.NewCoin("axpla", sdkmath.NewInt(1))),
+	}
+
+	tests := []struct {
+		name            string
+		pendingReserves []types.PendingReserve
+		wantErr         bool
+	}{
+		{"success", []types.PendingReserve{pendingReserve}, false},
+		{"duplicate pending reserve", []types.PendingReserve{pendingReserve, pendingReserve}, true},
+		{"invalid account", []types.PendingReserve{{Account: "a", ReserveAccount: reserveAccount, Amount: pendingReserve.Amount}}, true},
+		{"invalid reserve account", []types.PendingReserve{{Account: account, ReserveAccount: "", Amount: pendingReserve.Amount}}, true},
+		{"empty amount", []types.PendingReserve{{Account: account, ReserveAccount: reserveAccount}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidateGenesis(types.NewGenesisState(types.DefaultParams(), nil, tt.pendingReserves))
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
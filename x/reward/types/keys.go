@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName is the module name constant used in many places
 	ModuleName = "reward"
@@ -9,8 +14,28 @@ const (
 
 	// RouterKey is the message route for reward
 	RouterKey = ModuleName
+
+	// ReserveEscrowName is the module account holding the pending reserves
+	ReserveEscrowName = "reward_reserve_escrow"
+
+	// MaxPendingReserveRetryInterval is the maximum number of blocks between
+	// two retries of a pending reserve
+	MaxPendingReserveRetryInterval int64 = 14_400
 )
 
 var (
-	ParamsKey = []byte{0x01} // key for reward module params
+	ParamsKey                   = []byte{0x01} // key for reward module params
+	DistributionRecordKeyPrefix = []byte{0x02} // prefix for each key to a distribution record
+	PendingReserveKeyPrefix     = []byte{0x03} // prefix for each key to a pending reserve
 )
+
+// GetDistributionRecordKey creates the key for the distribution record of a block height
+func GetDistributionRecordKey(height int64) []byte {
+	return append(DistributionRecordKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetPendingReserveKey creates the key for the pending reserve of a distribution
+// source account and its reserve account
+func GetPendingReserveKey(account, reserveAccount sdk.AccAddress) []byte {
+	return append(append(PendingReserveKeyPrefix, address.MustLengthPrefix(account)...), reserveAccount...)
+}
@@ -8,13 +8,16 @@ import (
 )
 
 const (
-	TypeMsgFundRewardPool = "fund_reward_pool"
-	TypeMsgUpdateParams   = "update_params"
+	TypeMsgFundRewardPool     = "fund_reward_pool"
+	TypeMsgUpdateParams       = "update_params"
+	TypeMsgWithdrawRewardPool = "withdraw_reward_pool"
 )
 
 var (
 	_ sdk.Msg = (*MsgFundRewardPool)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgWithdrawRewardPool)(nil)
+	_ sdk.Msg = (*MsgResolvePendingReserve)(nil)
 )
 
 // NewMsgFundRewardPool returns a new MsgFundRewardPool with a sender and
@@ -46,3 +49,66 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 
 	return msg.Params.ValidateBasic()
 }
+
+// NewMsgWithdrawRewardPool returns a new MsgWithdrawRewardPool with an
+// authority, a recipient and a withdrawal amount.
+func NewMsgWithdrawRewardPool(authority string, amount sdk.Coins, recipient string) *MsgWithdrawRewardPool {
+	return &MsgWithdrawRewardPool{
+		Authority: authority,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+// ValidateBasic performs basic MsgWithdrawRewardPool message validation.
+func (msg *MsgWithdrawRewardPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if msg.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient)
+		}
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+// NewMsgResolvePendingReserve returns a new MsgResolvePendingReserve with an
+// authority, the accounts of the pending reserve and a recipient.
+func NewMsgResolvePendingReserve(authority, account, reserveAccount, recipient string) *MsgResolvePendingReserve {
+	return &MsgResolvePendingReserve{
+		Authority:      authority,
+		Account:        account,
+		ReserveAccount: reserveAccount,
+		Recipient:      recipient,
+	}
+}
+
+// ValidateBasic performs basic MsgResolvePendingReserve message validation.
+func (msg *MsgResolvePendingReserve) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, msg.Account)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.ReserveAccount); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, msg.ReserveAccount)
+	}
+
+	if msg.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient)
+		}
+	}
+
+	return nil
+}
@@ -66,3 +66,59 @@ func TestMsgUpdateValidateBasic(t *testing.T) {
 
 	}
 }
+
+func TestMsgWithdrawRewardPool(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	amount := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.OneInt()))
+
+	tests := []struct {
+		name       string
+		msg        *types.MsgWithdrawRewardPool
+		expectPass bool
+	}{
+		{"valid recipient", types.NewMsgWithdrawRewardPool(authority, amount, sdk.AccAddress(make([]byte, 20)).String()), true},
+		{"community pool", types.NewMsgWithdrawRewardPool(authority, amount, ""), true},
+		{"invalid authority", types.NewMsgWithdrawRewardPool("invalid", amount, ""), false},
+		{"invalid recipient", types.NewMsgWithdrawRewardPool(authority, amount, "invalid"), false},
+		{"empty amount", types.NewMsgWithdrawRewardPool(authority, sdk.Coins{}, ""), false},
+		{"invalid amount", types.NewMsgWithdrawRewardPool(authority, sdk.Coins{sdk.Coin{Denom: "1", Amount: sdkmath.OneInt()}}, ""), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.NoError(t, tc.msg.ValidateBasic())
+			} else {
+				require.Error(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgResolvePendingReserve(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	account := sdk.AccAddress(make([]byte, 20)).String()
+
+	tests := []struct {
+		name       string
+		msg        *types.MsgResolvePendingReserve
+		expectPass bool
+	}{
+		{"valid recipient", types.NewMsgResolvePendingReserve(authority, account, account, account), true},
+		{"community pool", types.NewMsgResolvePendingReserve(authority, account, account, ""), true},
+		{"invalid authority", types.NewMsgResolvePendingReserve("invalid", account, account, ""), false},
+		{"invalid account", types.NewMsgResolvePendingReserve(authority, "invalid", account, ""), false},
+		{"invalid reserve account", types.NewMsgResolvePendingReserve(authority, account, "", ""), false},
+		{"invalid recipient", types.NewMsgResolvePendingReserve(authority, account, account, "invalid"), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.NoError(t, tc.msg.ValidateBasic())
+			} else {
+				require.Error(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
@@ -11,24 +11,27 @@ import (
 )
 
 const (
-	DefaultReserveAccount          = ""
-	DefaultRewardDistributeAccount = ""
+	DefaultDistributionHistoryLimit = uint64(0)
 )
 
 var (
-	DefaultRateFeePool       = sdkmath.LegacyNewDecWithPrec(20, 2) // 20%
-	DefaultRateCommunityPool = sdkmath.LegacyNewDecWithPrec(80, 2) // 80%
-	DefaultRateReserve       = sdkmath.LegacyNewDecWithPrec(0, 2)  // 0%
+	DefaultDripMode         = DripModeExponential
+	DefaultLinearDripAmount = sdk.Coins{}
+	DefaultTargetApr        = sdkmath.LegacyZeroDec()
 )
 
 // DefaultParams returns default reward parameters
 func DefaultParams() Params {
 	return Params{
-		FeePoolRate:             DefaultRateFeePool,
-		CommunityPoolRate:       DefaultRateCommunityPool,
-		ReserveRate:             DefaultRateReserve,
-		ReserveAccount:          DefaultReserveAccount,
-		RewardDistributeAccount: DefaultRewardDistributeAccount,
+		FeePoolRate:              sdkmath.LegacyZeroDec(),
+		CommunityPoolRate:        sdkmath.LegacyZeroDec(),
+		ReserveRate:              sdkmath.LegacyZeroDec(),
+		DistributionHistoryLimit: DefaultDistributionHistoryLimit,
+		DripMode:                 DefaultDripMode,
+		LinearDripAmount:         DefaultLinearDripAmount,
+		TargetApr:                DefaultTargetApr,
+		DistributionSources:      []DistributionSource{},
+		FundableDenoms:           []string{},
 	}
 }
 
@@ -37,37 +40,154 @@ func (p Params) String() string {
 	return string(out)
 }
 
-func (p Params) TotalRate() sdkmath.LegacyDec {
-	return p.CommunityPoolRate.Add(p.FeePoolRate).Add(p.ReserveRate)
-}
-
 // ValidateBasic performs basic validation on reward parameters.
 func (p Params) ValidateBasic() error {
-	if p.ReserveAccount == "" && p.ReserveRate.GT(sdkmath.LegacyZeroDec()) {
-		return fmt.Errorf("reserve account must be set up for reserve compensation")
+	if p.HasLegacyDistribution() {
+		return fmt.Errorf("legacy distribution parameters are deprecated, use distribution sources instead")
 	}
 
-	if p.CommunityPoolRate.IsNegative() {
-		return fmt.Errorf(
-			"community pool rate should be positive: %s", p.CommunityPoolRate,
-		)
+	accounts := make(map[string]struct{}, len(p.DistributionSources))
+	for _, source := range p.DistributionSources {
+		if err := source.Validate(); err != nil {
+			return err
+		}
+
+		if _, exist := accounts[source.Account]; exist {
+			return fmt.Errorf("duplicated distribution source account: %s", source.Account)
+		}
+		accounts[source.Account] = struct{}{}
 	}
 
-	if p.FeePoolRate.IsNegative() {
-		return fmt.Errorf(
-			"fee pool rate should be positive: %s", p.FeePoolRate,
-		)
+	denoms := make(map[string]struct{}, len(p.FundableDenoms))
+	for _, denom := range p.FundableDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid fundable denom: %w", err)
+		}
+
+		if _, exist := denoms[denom]; exist {
+			return fmt.Errorf("duplicated fundable denom: %s", denom)
+		}
+		denoms[denom] = struct{}{}
 	}
 
-	if p.ReserveRate.IsNegative() {
-		return fmt.Errorf(
-			"reserve rate should be positive: %s", p.ReserveRate,
-		)
+	return p.validateDrip()
+}
+
+// IsFundableDenom returns true if the denom can fund the reward pool.
+func (p Params) IsFundableDenom(denom string) bool {
+	if len(p.FundableDenoms) == 0 {
+		return true
+	}
+
+	for _, fundableDenom := range p.FundableDenoms {
+		if fundableDenom == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SplitFundableCoins splits coins into the coins of the fundable denoms and the
+// others.
+func (p Params) SplitFundableCoins(coins sdk.Coins) (fundable, others sdk.Coins) {
+	fundable, others = sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range coins {
+		if p.IsFundableDenom(coin.Denom) {
+			fundable = fundable.Add(coin)
+		} else {
+			others = others.Add(coin)
+		}
+	}
+
+	return fundable, others
+}
+
+// HasLegacyDistribution returns true if any of the deprecated scalar
+// distribution parameters is set.
+func (p Params) HasLegacyDistribution() bool {
+	return !isZeroDec(p.FeePoolRate) || !isZeroDec(p.CommunityPoolRate) || !isZeroDec(p.ReserveRate) ||
+		p.ReserveAccount != "" || p.RewardDistributeAccount != ""
+}
+
+func isZeroDec(d sdkmath.LegacyDec) bool {
+	return d.IsNil() || d.IsZero()
+}
+
+// validateDrip validates the drip mode and the parameters it depends on.
+// A nil target APR is accepted for parameters migrated from x/params.
+func (p Params) validateDrip() error {
+	if !p.TargetApr.IsNil() && p.TargetApr.IsNegative() {
+		return fmt.Errorf("target apr should be positive: %s", p.TargetApr)
+	}
+
+	if err := p.LinearDripAmount.Validate(); err != nil {
+		return fmt.Errorf("invalid linear drip amount: %w", err)
+	}
+
+	switch p.DripMode {
+	case DripModeExponential:
+	case DripModeLinear:
+		if p.LinearDripAmount.IsZero() {
+			return fmt.Errorf("linear drip amount must be set up for the linear drip mode")
+		}
+	case DripModeTargetApr:
+		if p.TargetApr.IsNil() || !p.TargetApr.IsPositive() {
+			return fmt.Errorf("target apr must be positive for the target apr drip mode")
+		}
+	default:
+		return fmt.Errorf("invalid drip mode: %d", p.DripMode)
+	}
+
+	return nil
+}
+
+// NewDistributionSource creates a new DistributionSource instance
+func NewDistributionSource(
+	account string, feePoolRate, communityPoolRate, reserveRate sdkmath.LegacyDec, reserveAccount string,
+) DistributionSource {
+	return DistributionSource{
+		Account:           account,
+		FeePoolRate:       feePoolRate,
+		CommunityPoolRate: communityPoolRate,
+		ReserveRate:       reserveRate,
+		ReserveAccount:    reserveAccount,
+	}
+}
+
+func (s DistributionSource) TotalRate() sdkmath.LegacyDec {
+	return s.CommunityPoolRate.Add(s.FeePoolRate).Add(s.ReserveRate)
+}
+
+// Validate performs basic validation on a distribution source.
+func (s DistributionSource) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Account); err != nil {
+		return fmt.Errorf("invalid distribution source account: %s", err.Error())
+	}
+
+	if err := validateFeePoolRate(s.FeePoolRate); err != nil {
+		return err
+	}
+
+	if err := validateCommunityPoolRate(s.CommunityPoolRate); err != nil {
+		return err
+	}
+
+	if err := validateReserveRate(s.ReserveRate); err != nil {
+		return err
+	}
+
+	if s.ReserveAccount == "" && s.ReserveRate.GT(sdkmath.LegacyZeroDec()) {
+		return fmt.Errorf("reserve account must be set up for reserve compensation")
+	}
+
+	if err := validateAccount(s.ReserveAccount); err != nil {
+		return err
 	}
 
-	if p.TotalRate().GT(sdkmath.LegacyOneDec()) {
+	if s.TotalRate().GT(sdkmath.LegacyOneDec()) {
 		return fmt.Errorf(
-			"sum of fee pool, community pool and reserve cannot be greater than one: %s", p.TotalRate(),
+			"sum of fee pool, community pool and reserve cannot be greater than one: %s", s.TotalRate(),
 		)
 	}
 
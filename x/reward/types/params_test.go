@@ -5,32 +5,100 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/xpladev/xpla/x/reward/types"
 )
 
+const (
+	testAccount        = "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a"
+	testReserveAccount = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+)
+
 func TestParms_ValidateBasic(t *testing.T) {
+	source := types.NewDistributionSource(testAccount, sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(80, 2), sdkmath.LegacyNewDecWithPrec(0, 2), "")
+
+	tests := []struct {
+		name     string
+		malleate func(*types.Params)
+		wantErr  bool
+	}{
+		{"success", func(p *types.Params) { p.DistributionSources = []types.DistributionSource{source} }, false},
+		{"duplicated distribution source", func(p *types.Params) { p.DistributionSources = []types.DistributionSource{source, source} }, true},
+		{"invalid distribution source", func(p *types.Params) {
+			p.DistributionSources = []types.DistributionSource{types.NewDistributionSource("", sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(80, 2), sdkmath.LegacyNewDecWithPrec(0, 2), "")}
+		}, true},
+		{"legacy reward distribute account", func(p *types.Params) { p.RewardDistributeAccount = testAccount }, true},
+		{"legacy fee pool rate", func(p *types.Params) { p.FeePoolRate = sdkmath.LegacyNewDecWithPrec(20, 2) }, true},
+		{"linear drip mode", func(p *types.Params) {
+			p.DripMode = types.DripModeLinear
+			p.LinearDripAmount = sdk.NewCoins(sdk.NewInt64Coin("axpla", 100))
+		}, false},
+		{"linear drip mode without amount", func(p *types.Params) { p.DripMode = types.DripModeLinear }, true},
+		{"target apr drip mode", func(p *types.Params) {
+			p.DripMode = types.DripModeTargetApr
+			p.TargetApr = sdkmath.LegacyNewDecWithPrec(5, 2)
+		}, false},
+		{"target apr drip mode without apr", func(p *types.Params) { p.DripMode = types.DripModeTargetApr }, true},
+		{"negative target apr", func(p *types.Params) { p.TargetApr = sdkmath.LegacyNewDecWithPrec(-5, 2) }, true},
+		{"invalid drip mode", func(p *types.Params) { p.DripMode = types.DripMode(3) }, true},
+		{"fundable denoms", func(p *types.Params) { p.FundableDenoms = []string{"axpla", "ibc/ABCD"} }, false},
+		{"invalid fundable denom", func(p *types.Params) { p.FundableDenoms = []string{"1"} }, true},
+		{"duplicated fundable denom", func(p *types.Params) { p.FundableDenoms = []string{"axpla", "axpla"} }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tt.malleate(&params)
+			if err := params.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDistributionSource_Validate(t *testing.T) {
 	tests := []struct {
 		name    string
-		fields  types.Params
+		fields  types.DistributionSource
 		wantErr bool
 	}{
-		{"success", types.Params{sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(80, 2), sdkmath.LegacyNewDecWithPrec(0, 2), "", ""}, false},
-		{"empty reserve account with reserver account rate ", types.Params{sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(79, 2), sdkmath.LegacyNewDecWithPrec(1, 2), "", ""}, true},
-		{"nagative fee pool rate", types.Params{sdkmath.LegacyNewDecWithPrec(-20, 2), sdkmath.LegacyNewDecWithPrec(79, 2), sdkmath.LegacyNewDecWithPrec(0, 2), "", ""}, true},
-		{"nagative community pool rate", types.Params{sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(-79, 2), sdkmath.LegacyNewDecWithPrec(0, 2), "", ""}, true},
-		{"nagative reserve pool rate", types.Params{sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(79, 2), sdkmath.LegacyNewDecWithPrec(-1, 2), "aaaa", ""}, true},
-		{"total rate is more than one", types.Params{sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(79, 2), sdkmath.LegacyNewDecWithPrec(2, 2), "aaaa", ""}, true},
+		{"success", types.DistributionSource{testAccount, sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(80, 2), sdkmath.LegacyNewDecWithPrec(0, 2), ""}, false},
+		{"success with reserve", types.DistributionSource{testAccount, sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(79, 2), sdkmath.LegacyNewDecWithPrec(1, 2), testReserveAccount}, false},
+		{"empty account", types.DistributionSource{"", sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(80, 2), sdkmath.LegacyNewDecWithPrec(0, 2), ""}, true},
+		{"empty reserve account with reserver account rate ", types.DistributionSource{testAccount, sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(79, 2), sdkmath.LegacyNewDecWithPrec(1, 2), ""}, true},
+		{"invalid reserve account", types.DistributionSource{testAccount, sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(79, 2), sdkmath.LegacyNewDecWithPrec(1, 2), "aaaa"}, true},
+		{"nagative fee pool rate", types.DistributionSource{testAccount, sdkmath.LegacyNewDecWithPrec(-20, 2), sdkmath.LegacyNewDecWithPrec(79, 2), sdkmath.LegacyNewDecWithPrec(0, 2), ""}, true},
+		{"nagative community pool rate", types.DistributionSource{testAccount, sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(-79, 2), sdkmath.LegacyNewDecWithPrec(0, 2), ""}, true},
+		{"nagative reserve pool rate", types.DistributionSource{testAccount, sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(79, 2), sdkmath.LegacyNewDecWithPrec(-1, 2), testReserveAccount}, true},
+		{"total rate is more than one", types.DistributionSource{testAccount, sdkmath.LegacyNewDecWithPrec(20, 2), sdkmath.LegacyNewDecWithPrec(79, 2), sdkmath.LegacyNewDecWithPrec(2, 2), testReserveAccount}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.fields.ValidateBasic(); (err != nil) != tt.wantErr {
-				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			if err := tt.fields.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
+func TestParams_SplitFundableCoins(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("axpla", 10), sdk.NewInt64Coin("dust", 1))
+
+	params := types.DefaultParams()
+	fundable, others := params.SplitFundableCoins(coins)
+	require.Equal(t, coins, fundable)
+	require.True(t, others.IsZero())
+
+	params.FundableDenoms = []string{"axpla"}
+	require.True(t, params.IsFundableDenom("axpla"))
+	require.False(t, params.IsFundableDenom("dust"))
+
+	fundable, others = params.SplitFundableCoins(coins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("axpla", 10)), fundable)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("dust", 1)), others)
+}
+
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
@@ -5,9 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -158,6 +160,11 @@ var xxx_messageInfo_QueryPoolRequest proto.InternalMessageInfo
 type QueryPoolResponse struct {
 	// pool defines reward pool's coins.
 	Pool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool"`
+	// eligible_pool defines reward pool's coins of the fundable denoms, which
+	// are dripped to the fee collector.
+	EligiblePool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=eligible_pool,json=eligiblePool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"eligible_pool"`
+	// ineligible_pool defines reward pool's coins of the other denoms.
+	IneligiblePool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=ineligible_pool,json=ineligiblePool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ineligible_pool"`
 }
 
 func (m *QueryPoolResponse) Reset()         { *m = QueryPoolResponse{} }
@@ -200,45 +207,396 @@ func (m *QueryPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *QueryPoolResponse) GetEligiblePool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EligiblePool
+	}
+	return nil
+}
+
+func (m *QueryPoolResponse) GetIneligiblePool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.IneligiblePool
+	}
+	return nil
+}
+
+// QueryDistributionHistoryRequest is the request type for the
+// Query/DistributionHistory RPC method.
+type QueryDistributionHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDistributionHistoryRequest) Reset()         { *m = QueryDistributionHistoryRequest{} }
+func (m *QueryDistributionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionHistoryRequest) ProtoMessage()    {}
+func (*QueryDistributionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8f701af23fca524, []int{4}
+}
+func (m *QueryDistributionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionHistoryRequest.Merge(m, src)
+}
+func (m *QueryDistributionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryDistributionHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDistributionHistoryResponse is the response type for the
+// Query/DistributionHistory RPC method.
+type QueryDistributionHistoryResponse struct {
+	// records defines the distribution records ordered by height.
+	Records []DistributionRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDistributionHistoryResponse) Reset()         { *m = QueryDistributionHistoryResponse{} }
+func (m *QueryDistributionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionHistoryResponse) ProtoMessage()    {}
+func (*QueryDistributionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8f701af23fca524, []int{5}
+}
+func (m *QueryDistributionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionHistoryResponse.Merge(m, src)
+}
+func (m *QueryDistributionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionHistoryResponse) GetRecords() []DistributionRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryDistributionHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingReserveRequest is the request type for the Query/PendingReserve
+// RPC method.
+type QueryPendingReserveRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingReserveRequest) Reset()         { *m = QueryPendingReserveRequest{} }
+func (m *QueryPendingReserveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingReserveRequest) ProtoMessage()    {}
+func (*QueryPendingReserveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8f701af23fca524, []int{6}
+}
+func (m *QueryPendingReserveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingReserveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingReserveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingReserveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingReserveRequest.Merge(m, src)
+}
+func (m *QueryPendingReserveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingReserveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingReserveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingReserveRequest proto.InternalMessageInfo
+
+func (m *QueryPendingReserveRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingReserveResponse is the response type for the
+// Query/PendingReserve RPC method.
+type QueryPendingReserveResponse struct {
+	// pending_reserves defines the reserve rewards waiting to be sent to the
+	// reserve accounts.
+	PendingReserves []PendingReserve `protobuf:"bytes,1,rep,name=pending_reserves,json=pendingReserves,proto3" json:"pending_reserves"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingReserveResponse) Reset()         { *m = QueryPendingReserveResponse{} }
+func (m *QueryPendingReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingReserveResponse) ProtoMessage()    {}
+func (*QueryPendingReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8f701af23fca524, []int{7}
+}
+func (m *QueryPendingReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingReserveResponse.Merge(m, src)
+}
+func (m *QueryPendingReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingReserveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingReserveResponse proto.InternalMessageInfo
+
+func (m *QueryPendingReserveResponse) GetPendingReserves() []PendingReserve {
+	if m != nil {
+		return m.PendingReserves
+	}
+	return nil
+}
+
+func (m *QueryPendingReserveResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEmissionProjectionRequest is the request type for the
+// Query/EmissionProjection RPC method.
+type QueryEmissionProjectionRequest struct {
+	// blocks is the number of the next blocks to project. It must not exceed the
+	// blocks per year of the mint params.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *QueryEmissionProjectionRequest) Reset()         { *m = QueryEmissionProjectionRequest{} }
+func (m *QueryEmissionProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionProjectionRequest) ProtoMessage()    {}
+func (*QueryEmissionProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8f701af23fca524, []int{8}
+}
+func (m *QueryEmissionProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionProjectionRequest.Merge(m, src)
+}
+func (m *QueryEmissionProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryEmissionProjectionRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// QueryEmissionProjectionResponse is the response type for the
+// Query/EmissionProjection RPC method.
+type QueryEmissionProjectionResponse struct {
+	// amount is the amount dripped to the fee collector over the blocks,
+	// assuming that no more rewards are added to the reward pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// apr is the annual rate implied by the bond denom amount against the
+	// bonded tokens.
+	Apr cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+}
+
+func (m *QueryEmissionProjectionResponse) Reset()         { *m = QueryEmissionProjectionResponse{} }
+func (m *QueryEmissionProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionProjectionResponse) ProtoMessage()    {}
+func (*QueryEmissionProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8f701af23fca524, []int{9}
+}
+func (m *QueryEmissionProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionProjectionResponse.Merge(m, src)
+}
+func (m *QueryEmissionProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionProjectionResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "xpla.reward.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "xpla.reward.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryPoolRequest)(nil), "xpla.reward.v1beta1.QueryPoolRequest")
 	proto.RegisterType((*QueryPoolResponse)(nil), "xpla.reward.v1beta1.QueryPoolResponse")
+	proto.RegisterType((*QueryDistributionHistoryRequest)(nil), "xpla.reward.v1beta1.QueryDistributionHistoryRequest")
+	proto.RegisterType((*QueryDistributionHistoryResponse)(nil), "xpla.reward.v1beta1.QueryDistributionHistoryResponse")
+	proto.RegisterType((*QueryPendingReserveRequest)(nil), "xpla.reward.v1beta1.QueryPendingReserveRequest")
+	proto.RegisterType((*QueryPendingReserveResponse)(nil), "xpla.reward.v1beta1.QueryPendingReserveResponse")
+	proto.RegisterType((*QueryEmissionProjectionRequest)(nil), "xpla.reward.v1beta1.QueryEmissionProjectionRequest")
+	proto.RegisterType((*QueryEmissionProjectionResponse)(nil), "xpla.reward.v1beta1.QueryEmissionProjectionResponse")
 }
 
 func init() { proto.RegisterFile("xpla/reward/v1beta1/query.proto", fileDescriptor_e8f701af23fca524) }
 
 var fileDescriptor_e8f701af23fca524 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x33, 0xeb, 0x5a, 0x70, 0xd6, 0x83, 0x3b, 0xbb, 0x87, 0xdd, 0x54, 0xd3, 0x1a, 0x71,
-	0x0d, 0x0b, 0xce, 0xb0, 0xf5, 0x2e, 0x52, 0xc1, 0xb3, 0x2e, 0x78, 0xf1, 0x22, 0x93, 0x74, 0x88,
-	0xc1, 0x24, 0x2f, 0x9b, 0x49, 0x6a, 0x7b, 0x13, 0xf1, 0xe4, 0x49, 0xf0, 0xe6, 0x5f, 0x20, 0x9e,
-	0xfa, 0x67, 0xf4, 0x58, 0xf0, 0xe2, 0x49, 0xa5, 0x15, 0xfa, 0x6f, 0xc8, 0xfc, 0x68, 0xad, 0x18,
-	0xb6, 0x97, 0x36, 0xbc, 0xf7, 0x7d, 0xef, 0xf3, 0xbe, 0xdf, 0x04, 0x77, 0x46, 0x45, 0xca, 0x59,
-	0x29, 0xde, 0xf0, 0x72, 0xc0, 0x86, 0x67, 0xa1, 0xa8, 0xf8, 0x19, 0xbb, 0xa8, 0x45, 0x39, 0xa6,
-	0x45, 0x09, 0x15, 0x90, 0x03, 0x25, 0xa0, 0x46, 0x40, 0xad, 0xc0, 0x3d, 0x8c, 0x21, 0x06, 0xdd,
-	0x67, 0xea, 0xc9, 0x48, 0xdd, 0x9b, 0x31, 0x40, 0x9c, 0x0a, 0xc6, 0x8b, 0x84, 0xf1, 0x3c, 0x87,
-	0x8a, 0x57, 0x09, 0xe4, 0xd2, 0x76, 0xbd, 0x08, 0x64, 0x06, 0x92, 0x85, 0x5c, 0x8a, 0x35, 0x29,
-	0x82, 0x24, 0xb7, 0xfd, 0x6e, 0xd3, 0x25, 0x96, 0x6b, 0x14, 0xfb, 0x3c, 0x4b, 0x72, 0x60, 0xfa,
-	0xd7, 0x94, 0xfc, 0x43, 0x4c, 0x9e, 0xa9, 0x63, 0x9f, 0xf2, 0x92, 0x67, 0xf2, 0x5c, 0x5c, 0xd4,
-	0x42, 0x56, 0xfe, 0x73, 0x7c, 0xf0, 0x4f, 0x55, 0x16, 0x90, 0x4b, 0x41, 0x1e, 0xe2, 0x56, 0xa1,
-	0x2b, 0x47, 0xa8, 0x8b, 0x82, 0xbd, 0x5e, 0x9b, 0x36, 0x78, 0xa3, 0x66, 0xa8, 0x7f, 0x6d, 0xfa,
-	0xa3, 0xe3, 0x7c, 0x59, 0x4e, 0x4e, 0xd1, 0xb9, 0x9d, 0xf2, 0x09, 0xbe, 0x61, 0xd6, 0x02, 0xa4,
-	0x2b, 0xd4, 0x07, 0x84, 0xf7, 0x37, 0x8a, 0x96, 0x54, 0xe3, 0xdd, 0x02, 0x20, 0x3d, 0x42, 0xdd,
-	0x2b, 0xc1, 0x5e, 0xef, 0x98, 0x1a, 0xeb, 0x54, 0x59, 0x5f, 0x73, 0x1e, 0x43, 0x92, 0xf7, 0x9f,
-	0x28, 0xca, 0xd7, 0x9f, 0x9d, 0x20, 0x4e, 0xaa, 0x57, 0x75, 0x48, 0x23, 0xc8, 0x98, 0xcd, 0xc9,
-	0xfc, 0xdd, 0x97, 0x83, 0xd7, 0xac, 0x1a, 0x17, 0x42, 0xea, 0x01, 0xf9, 0x79, 0x39, 0x39, 0xbd,
-	0x9e, 0x8a, 0x98, 0x47, 0xe3, 0x97, 0x2a, 0x3c, 0x69, 0x4e, 0xd4, 0xb8, 0xde, 0xfb, 0x1d, 0x7c,
-	0x55, 0x1f, 0x43, 0xde, 0x22, 0xdc, 0x32, 0x46, 0xc8, 0xbd, 0x46, 0x97, 0xff, 0xa7, 0xe6, 0x06,
-	0xdb, 0x85, 0xc6, 0x9e, 0x7f, 0xe7, 0xdd, 0xb7, 0xdf, 0x9f, 0x76, 0x6e, 0x91, 0x36, 0x6b, 0x7a,
-	0x67, 0x26, 0x2d, 0x32, 0xc2, 0xbb, 0x2a, 0x13, 0x72, 0xf7, 0x92, 0xb5, 0x7f, 0x83, 0x74, 0x4f,
-	0xb6, 0xc9, 0x2c, 0xfb, 0xb6, 0x66, 0xb7, 0xc9, 0x71, 0x33, 0x1b, 0x20, 0xed, 0x3f, 0x9a, 0xce,
-	0x3d, 0x34, 0x9b, 0x7b, 0xe8, 0xd7, 0xdc, 0x43, 0x1f, 0x17, 0x9e, 0x33, 0x5b, 0x78, 0xce, 0xf7,
-	0x85, 0xe7, 0xbc, 0x38, 0xd9, 0x88, 0x59, 0x8d, 0x0f, 0xc4, 0xd0, 0xac, 0x19, 0xad, 0x16, 0xe9,
-	0xa8, 0xc3, 0x96, 0xfe, 0xba, 0x1e, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0x79, 0xf9, 0xae, 0x4b,
-	0x1e, 0x03, 0x00, 0x00,
+	// 838 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0xd6, 0x59, 0xae, 0x0a, 0x9f, 0x5d, 0xff, 0x38, 0x1b, 0x85, 0x4d, 0xb5, 0x94, 0x2a, 0xb7,
+	0xb2, 0xaa, 0xb6, 0xa4, 0x7f, 0x02, 0x45, 0x87, 0xa2, 0x50, 0x5d, 0x37, 0x83, 0x07, 0x47, 0x40,
+	0x86, 0x64, 0x11, 0x28, 0xf2, 0x40, 0x5d, 0x4c, 0xf1, 0x68, 0x1e, 0xe5, 0x58, 0x5b, 0x90, 0x21,
+	0x43, 0xa6, 0x00, 0xc9, 0x94, 0x29, 0xd9, 0x82, 0x4c, 0xce, 0x90, 0x21, 0x63, 0xa6, 0x78, 0x34,
+	0x90, 0x25, 0xc8, 0xe0, 0x04, 0x76, 0x00, 0xff, 0x1b, 0x01, 0xef, 0x4e, 0x12, 0x09, 0x53, 0x56,
+	0x0c, 0x18, 0x5e, 0xf4, 0xe3, 0xde, 0xfb, 0xde, 0xf7, 0xf1, 0xbb, 0xf7, 0x9e, 0x04, 0x73, 0x7b,
+	0x9e, 0x63, 0xe8, 0x3e, 0xbe, 0x63, 0xf8, 0x96, 0xbe, 0xbb, 0x54, 0xc7, 0x81, 0xb1, 0xa4, 0xef,
+	0xb4, 0xb0, 0xdf, 0xd6, 0x3c, 0x9f, 0x06, 0x14, 0x4d, 0x87, 0x09, 0x9a, 0x48, 0xd0, 0x64, 0x82,
+	0x32, 0x63, 0x53, 0x9b, 0xf2, 0xb8, 0x1e, 0x7e, 0x12, 0xa9, 0xca, 0x0f, 0x36, 0xa5, 0xb6, 0x83,
+	0x75, 0xc3, 0x23, 0xba, 0xe1, 0xba, 0x34, 0x30, 0x02, 0x42, 0x5d, 0x26, 0xa3, 0xaa, 0x49, 0x59,
+	0x93, 0x32, 0xbd, 0x6e, 0x30, 0xdc, 0x65, 0x32, 0x29, 0x71, 0x65, 0xbc, 0x1c, 0x8d, 0x73, 0x05,
+	0xdd, 0x2c, 0xcf, 0xb0, 0x89, 0xcb, 0x8b, 0xc9, 0xdc, 0x7c, 0x92, 0x6a, 0xa9, 0x51, 0x64, 0x4c,
+	0x19, 0x4d, 0xe2, 0x52, 0x9d, 0xbf, 0x8a, 0xa3, 0xc2, 0x0c, 0x44, 0xd7, 0xc3, 0xb2, 0x5b, 0x86,
+	0x6f, 0x34, 0x59, 0x15, 0xef, 0xb4, 0x30, 0x0b, 0x0a, 0x37, 0xe0, 0x74, 0xec, 0x94, 0x79, 0xd4,
+	0x65, 0x18, 0xfd, 0x0d, 0x33, 0x1e, 0x3f, 0x99, 0x05, 0x79, 0x50, 0x1a, 0x5d, 0xce, 0x6a, 0x09,
+	0x3e, 0x68, 0x02, 0x54, 0x19, 0x39, 0x38, 0xca, 0xa5, 0x9e, 0x9f, 0xee, 0x97, 0x41, 0x55, 0xa2,
+	0x0a, 0x08, 0x4e, 0x8a, 0xb2, 0x94, 0x3a, 0x1d, 0xaa, 0xa7, 0x69, 0x38, 0x15, 0x39, 0x94, 0x4c,
+	0x2d, 0x38, 0xec, 0x51, 0xea, 0xcc, 0x82, 0x7c, 0xba, 0x34, 0xba, 0x3c, 0xa7, 0x09, 0x1b, 0xb4,
+	0xd0, 0x86, 0x2e, 0xcf, 0xbf, 0x94, 0xb8, 0x95, 0x8d, 0x90, 0xe5, 0xc5, 0xc7, 0x5c, 0xc9, 0x26,
+	0x41, 0xa3, 0x55, 0xd7, 0x4c, 0xda, 0xd4, 0xa5, 0x67, 0xe2, 0xed, 0x0f, 0x66, 0x6d, 0xeb, 0x41,
+	0xdb, 0xc3, 0x8c, 0x03, 0xd8, 0x93, 0xd3, 0xfd, 0xf2, 0x98, 0x83, 0x6d, 0xc3, 0x6c, 0xd7, 0x42,
+	0xa3, 0x99, 0x90, 0xc8, 0xe9, 0xd0, 0x7d, 0x00, 0xbf, 0xc3, 0x0e, 0xb1, 0x49, 0xdd, 0xc1, 0x35,
+	0x2e, 0x60, 0xe8, 0xaa, 0x04, 0x8c, 0x75, 0x78, 0x43, 0x1f, 0xd0, 0x03, 0x00, 0x27, 0x88, 0x1b,
+	0x97, 0x92, 0xbe, 0x2a, 0x29, 0xe3, 0x3d, 0xe6, 0x50, 0x4c, 0x81, 0xc0, 0x1c, 0xbf, 0xa1, 0x75,
+	0xc2, 0x02, 0x9f, 0xd4, 0x5b, 0x61, 0xcf, 0x5d, 0x23, 0x2c, 0xa0, 0x7e, 0x5b, 0xde, 0x22, 0xda,
+	0x80, 0xb0, 0xd7, 0x8f, 0xb2, 0x3b, 0x8a, 0x31, 0xa5, 0x62, 0x7c, 0x7a, 0x3d, 0x62, 0x63, 0x89,
+	0xad, 0x46, 0x90, 0x85, 0xd7, 0x00, 0xe6, 0xfb, 0x73, 0xc9, 0xe6, 0xd8, 0x84, 0xdf, 0xfa, 0xd8,
+	0xa4, 0xbe, 0xc5, 0x64, 0x7f, 0x2c, 0x24, 0xf6, 0x61, 0xb4, 0x44, 0x95, 0xe7, 0x47, 0x7b, 0xb2,
+	0x53, 0x02, 0xfd, 0x1f, 0x93, 0x3e, 0xc4, 0xa5, 0x2f, 0x0c, 0x94, 0x2e, 0xa4, 0xc4, 0xb4, 0x5b,
+	0x50, 0x11, 0x8d, 0x8c, 0x5d, 0x8b, 0xb8, 0x76, 0x15, 0x33, 0xec, 0xef, 0xe2, 0xcb, 0x76, 0xe8,
+	0x0d, 0x80, 0xd9, 0x44, 0x1a, 0x69, 0xce, 0x4d, 0x38, 0xe9, 0x89, 0x48, 0xcd, 0x17, 0xa1, 0x8e,
+	0x4b, 0xf3, 0xc9, 0xd3, 0x1a, 0x2b, 0x13, 0x75, 0x68, 0xc2, 0x8b, 0x85, 0x2e, 0xd1, 0xa9, 0x3f,
+	0xa1, 0xca, 0x1f, 0xe1, 0xbf, 0x26, 0x61, 0x8c, 0x50, 0x77, 0xcb, 0xa7, 0xb7, 0xb1, 0x29, 0xee,
+	0x49, 0xb8, 0xf5, 0x3d, 0xcc, 0xd4, 0x1d, 0x6a, 0x6e, 0x8b, 0x4d, 0x33, 0x5c, 0x95, 0xdf, 0x0a,
+	0x6f, 0x81, 0xec, 0xc5, 0x24, 0xa8, 0x74, 0xa0, 0x01, 0x33, 0x46, 0x93, 0xb6, 0xdc, 0x60, 0xf0,
+	0xf6, 0x58, 0xbb, 0xe8, 0xc4, 0xc8, 0x7d, 0x26, 0xea, 0xa3, 0xbf, 0x60, 0xda, 0xf0, 0x7c, 0xee,
+	0xc4, 0x48, 0xa5, 0x14, 0xd6, 0xfa, 0x70, 0x94, 0xcb, 0x0a, 0x24, 0xb3, 0xb6, 0x35, 0x42, 0xf5,
+	0xa6, 0x11, 0x34, 0xb4, 0x4d, 0x3e, 0x62, 0xeb, 0xd8, 0x14, 0xf0, 0x10, 0xb4, 0xfc, 0x38, 0x03,
+	0xbf, 0xe1, 0x4f, 0x82, 0xee, 0x02, 0x98, 0x11, 0x3b, 0x13, 0x25, 0x37, 0xf2, 0xd9, 0x05, 0xad,
+	0x94, 0x06, 0x27, 0x0a, 0x37, 0x0a, 0xf3, 0xf7, 0xde, 0x7d, 0x7e, 0x34, 0xf4, 0x23, 0xca, 0xea,
+	0x49, 0x3f, 0x0f, 0x62, 0x31, 0xa3, 0x3d, 0x38, 0xcc, 0xd7, 0xce, 0x2f, 0xe7, 0x94, 0xed, 0xed,
+	0x6c, 0xa5, 0x38, 0x28, 0x4d, 0x72, 0xff, 0xc4, 0xb9, 0xb3, 0x68, 0x2e, 0x99, 0x3b, 0x64, 0x7c,
+	0x05, 0xe0, 0x74, 0xc2, 0xac, 0xa3, 0xd5, 0xfe, 0x14, 0xfd, 0xd7, 0x90, 0xb2, 0x76, 0x41, 0x94,
+	0xd4, 0xb9, 0xc4, 0x75, 0xfe, 0x86, 0x7e, 0x4d, 0xd4, 0x69, 0x45, 0x90, 0xb5, 0x86, 0xd4, 0xf7,
+	0x0c, 0xc0, 0xf1, 0xf8, 0xe8, 0x20, 0xfd, 0x1c, 0x57, 0x92, 0x56, 0x82, 0xb2, 0xf8, 0xf5, 0x00,
+	0x29, 0xf4, 0x77, 0x2e, 0xb4, 0x88, 0x7e, 0x4e, 0x36, 0x34, 0x3e, 0xf7, 0xe8, 0x25, 0x80, 0xe8,
+	0xec, 0x9c, 0xa0, 0x95, 0xfe, 0xb4, 0x7d, 0x07, 0x52, 0x59, 0xbd, 0x18, 0x48, 0xea, 0x5d, 0xe4,
+	0x7a, 0xcb, 0xa8, 0x94, 0xa8, 0x17, 0x4b, 0x60, 0xcd, 0xeb, 0x22, 0x2b, 0xff, 0x1c, 0x1c, 0xab,
+	0xe0, 0xf0, 0x58, 0x05, 0x9f, 0x8e, 0x55, 0xf0, 0xf0, 0x44, 0x4d, 0x1d, 0x9e, 0xa8, 0xa9, 0xf7,
+	0x27, 0x6a, 0xea, 0x56, 0x31, 0x32, 0xa3, 0x61, 0x35, 0x0b, 0xef, 0x8a, 0xaa, 0x7b, 0x9d, 0xba,
+	0x7c, 0x4e, 0xeb, 0x19, 0xfe, 0xc7, 0x66, 0xe5, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xfb,
+	0xad, 0x13, 0xc5, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -257,6 +615,14 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Pool queries the reward module pool coins.
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// DistributionHistory queries the latest reward distribution records.
+	DistributionHistory(ctx context.Context, in *QueryDistributionHistoryRequest, opts ...grpc.CallOption) (*QueryDistributionHistoryResponse, error)
+	// PendingReserve queries the reserve rewards waiting to be sent to the
+	// reserve accounts.
+	PendingReserve(ctx context.Context, in *QueryPendingReserveRequest, opts ...grpc.CallOption) (*QueryPendingReserveResponse, error)
+	// EmissionProjection queries the amount dripped from the reward pool to the
+	// fee collector over the next blocks.
+	EmissionProjection(ctx context.Context, in *QueryEmissionProjectionRequest, opts ...grpc.CallOption) (*QueryEmissionProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -285,12 +651,47 @@ func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) DistributionHistory(ctx context.Context, in *QueryDistributionHistoryRequest, opts ...grpc.CallOption) (*QueryDistributionHistoryResponse, error) {
+	out := new(QueryDistributionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/xpla.reward.v1beta1.Query/DistributionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingReserve(ctx context.Context, in *QueryPendingReserveRequest, opts ...grpc.CallOption) (*QueryPendingReserveResponse, error) {
+	out := new(QueryPendingReserveResponse)
+	err := c.cc.Invoke(ctx, "/xpla.reward.v1beta1.Query/PendingReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionProjection(ctx context.Context, in *QueryEmissionProjectionRequest, opts ...grpc.CallOption) (*QueryEmissionProjectionResponse, error) {
+	out := new(QueryEmissionProjectionResponse)
+	err := c.cc.Invoke(ctx, "/xpla.reward.v1beta1.Query/EmissionProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the reward module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Pool queries the reward module pool coins.
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	// DistributionHistory queries the latest reward distribution records.
+	DistributionHistory(context.Context, *QueryDistributionHistoryRequest) (*QueryDistributionHistoryResponse, error)
+	// PendingReserve queries the reserve rewards waiting to be sent to the
+	// reserve accounts.
+	PendingReserve(context.Context, *QueryPendingReserveRequest) (*QueryPendingReserveResponse, error)
+	// EmissionProjection queries the amount dripped from the reward pool to the
+	// fee collector over the next blocks.
+	EmissionProjection(context.Context, *QueryEmissionProjectionRequest) (*QueryEmissionProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -303,6 +704,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
 }
+func (*UnimplementedQueryServer) DistributionHistory(ctx context.Context, req *QueryDistributionHistoryRequest) (*QueryDistributionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionHistory not implemented")
+}
+func (*UnimplementedQueryServer) PendingReserve(ctx context.Context, req *QueryPendingReserveRequest) (*QueryPendingReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingReserve not implemented")
+}
+func (*UnimplementedQueryServer) EmissionProjection(ctx context.Context, req *QueryEmissionProjectionRequest) (*QueryEmissionProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -344,6 +754,60 @@ func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DistributionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.reward.v1beta1.Query/DistributionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionHistory(ctx, req.(*QueryDistributionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.reward.v1beta1.Query/PendingReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingReserve(ctx, req.(*QueryPendingReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.reward.v1beta1.Query/EmissionProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionProjection(ctx, req.(*QueryEmissionProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "xpla.reward.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -356,6 +820,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Pool",
 			Handler:    _Query_Pool_Handler,
 		},
+		{
+			MethodName: "DistributionHistory",
+			Handler:    _Query_DistributionHistory_Handler,
+		},
+		{
+			MethodName: "PendingReserve",
+			Handler:    _Query_PendingReserve_Handler,
+		},
+		{
+			MethodName: "EmissionProjection",
+			Handler:    _Query_EmissionProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xpla/reward/v1beta1/query.proto",
@@ -460,10 +936,10 @@ func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pool) > 0 {
-		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.IneligiblePool) > 0 {
+		for iNdEx := len(m.IneligiblePool) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.IneligiblePool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -471,19 +947,290 @@ func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.EligiblePool) > 0 {
+		for iNdEx := len(m.EligiblePool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EligiblePool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingReserveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingReserveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingReserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingReserves) > 0 {
+		for iNdEx := len(m.PendingReserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -512,33 +1259,679 @@ func (m *QueryPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EligiblePool) > 0 {
+		for _, e := range m.EligiblePool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.IneligiblePool) > 0 {
+		for _, e := range m.IneligiblePool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDistributionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingReserveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingReserves) > 0 {
+		for _, e := range m.PendingReserves {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEmissionProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *QueryEmissionProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = append(m.Pool, types.Coin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligiblePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EligiblePool = append(m.EligiblePool, types.Coin{})
+			if err := m.EligiblePool[len(m.EligiblePool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IneligiblePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IneligiblePool = append(m.IneligiblePool, types.Coin{})
+			if err := m.IneligiblePool[len(m.IneligiblePool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Pool) > 0 {
-		for _, e := range m.Pool {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryDistributionHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, DistributionRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingReserveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -561,12 +1954,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingReserveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingReserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -588,7 +2017,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingReserveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -611,15 +2040,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingReserveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReserves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -646,7 +2075,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingReserves = append(m.PendingReserves, PendingReserve{})
+			if err := m.PendingReserves[len(m.PendingReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -671,7 +2137,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEmissionProjectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -694,12 +2160,31 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEmissionProjectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEmissionProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -721,7 +2206,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEmissionProjectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -744,15 +2229,15 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEmissionProjectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEmissionProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -779,8 +2264,42 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = append(m.Pool, types.Coin{})
-			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
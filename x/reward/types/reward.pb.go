@@ -6,6 +6,8 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -25,13 +27,69 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DripMode defines how the reward pool is dripped to the fee collector.
+type DripMode int32
+
+const (
+	// DRIP_MODE_EXPONENTIAL drips balance / blocks_per_year of x/mint every
+	// block.
+	DripModeExponential DripMode = 0
+	// DRIP_MODE_LINEAR drips linear_drip_amount every block until the pool is
+	// empty.
+	DripModeLinear DripMode = 1
+	// DRIP_MODE_TARGET_APR drips the bond denom amount which yields target_apr
+	// on the bonded tokens.
+	DripModeTargetApr DripMode = 2
+)
+
+var DripMode_name = map[int32]string{
+	0: "DRIP_MODE_EXPONENTIAL",
+	1: "DRIP_MODE_LINEAR",
+	2: "DRIP_MODE_TARGET_APR",
+}
+
+var DripMode_value = map[string]int32{
+	"DRIP_MODE_EXPONENTIAL": 0,
+	"DRIP_MODE_LINEAR":      1,
+	"DRIP_MODE_TARGET_APR":  2,
+}
+
+func (x DripMode) String() string {
+	return proto.EnumName(DripMode_name, int32(x))
+}
+
+func (DripMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cce4bfd3ebfaf11e, []int{0}
+}
+
 // Params defines the set of params for the reward module.
 type Params struct {
-	FeePoolRate             cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=fee_pool_rate,json=feePoolRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_pool_rate" yaml:"fee_pool_rate"`
-	CommunityPoolRate       cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_pool_rate,json=communityPoolRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_rate" yaml:"community_pool_rate"`
-	ReserveRate             cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=reserve_rate,json=reserveRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reserve_rate" yaml:"reserve_rate"`
-	ReserveAccount          string                      `protobuf:"bytes,4,opt,name=reserve_account,json=reserveAccount,proto3" json:"reserve_account,omitempty"`
-	RewardDistributeAccount string                      `protobuf:"bytes,5,opt,name=reward_distribute_account,json=rewardDistributeAccount,proto3" json:"reward_distribute_account,omitempty"`
+	// Deprecated: fee_pool_rate, community_pool_rate, reserve_rate,
+	// reserve_account and reward_distribute_account are replaced by
+	// distribution_sources. They are only kept to migrate the parameters of the
+	// consensus version 3 and must be left empty.
+	FeePoolRate             cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=fee_pool_rate,json=feePoolRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_pool_rate" yaml:"fee_pool_rate"`                         // Deprecated: Do not use.
+	CommunityPoolRate       cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_pool_rate,json=communityPoolRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_rate" yaml:"community_pool_rate"` // Deprecated: Do not use.
+	ReserveRate             cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=reserve_rate,json=reserveRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reserve_rate" yaml:"reserve_rate"`                            // Deprecated: Do not use.
+	ReserveAccount          string                      `protobuf:"bytes,4,opt,name=reserve_account,json=reserveAccount,proto3" json:"reserve_account,omitempty"`                                                                    // Deprecated: Do not use.
+	RewardDistributeAccount string                      `protobuf:"bytes,5,opt,name=reward_distribute_account,json=rewardDistributeAccount,proto3" json:"reward_distribute_account,omitempty"`                                       // Deprecated: Do not use.
+	// distribution_history_limit is the number of the latest distribution
+	// records kept in the store. Zero disables the distribution history.
+	DistributionHistoryLimit uint64 `protobuf:"varint,6,opt,name=distribution_history_limit,json=distributionHistoryLimit,proto3" json:"distribution_history_limit,omitempty"`
+	// drip_mode defines how the reward pool is dripped to the fee collector.
+	DripMode DripMode `protobuf:"varint,7,opt,name=drip_mode,json=dripMode,proto3,enum=xpla.reward.v1beta1.DripMode" json:"drip_mode,omitempty"`
+	// linear_drip_amount is the amount dripped every block in the linear drip
+	// mode.
+	LinearDripAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=linear_drip_amount,json=linearDripAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"linear_drip_amount"`
+	// target_apr is the annual reward rate on the bonded tokens in the target
+	// APR drip mode.
+	TargetApr cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=target_apr,json=targetApr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_apr" yaml:"target_apr"`
+	// distribution_sources defines the delegator accounts whose staking rewards
+	// are distributed every block.
+	DistributionSources []DistributionSource `protobuf:"bytes,10,rep,name=distribution_sources,json=distributionSources,proto3" json:"distribution_sources"`
+	// fundable_denoms defines the denoms which can fund the reward pool and are
+	// dripped to the fee collector. Every denom is fundable if it is empty.
+	FundableDenoms []string `protobuf:"bytes,11,rep,name=fundable_denoms,json=fundableDenoms,proto3" json:"fundable_denoms,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -66,6 +124,7 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *Params) GetReserveAccount() string {
 	if m != nil {
 		return m.ReserveAccount
@@ -73,6 +132,7 @@ func (m *Params) GetReserveAccount() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *Params) GetRewardDistributeAccount() string {
 	if m != nil {
 		return m.RewardDistributeAccount
@@ -80,39 +140,341 @@ func (m *Params) GetRewardDistributeAccount() string {
 	return ""
 }
 
+func (m *Params) GetDistributionHistoryLimit() uint64 {
+	if m != nil {
+		return m.DistributionHistoryLimit
+	}
+	return 0
+}
+
+func (m *Params) GetDripMode() DripMode {
+	if m != nil {
+		return m.DripMode
+	}
+	return DripModeExponential
+}
+
+func (m *Params) GetLinearDripAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LinearDripAmount
+	}
+	return nil
+}
+
+func (m *Params) GetDistributionSources() []DistributionSource {
+	if m != nil {
+		return m.DistributionSources
+	}
+	return nil
+}
+
+func (m *Params) GetFundableDenoms() []string {
+	if m != nil {
+		return m.FundableDenoms
+	}
+	return nil
+}
+
+// DistributionSource defines a delegator account whose staking rewards are
+// split between the fee pool, the community pool and a reserve account.
+type DistributionSource struct {
+	// account is the delegator account whose staking rewards are withdrawn.
+	Account           string                      `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	FeePoolRate       cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fee_pool_rate,json=feePoolRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_pool_rate" yaml:"fee_pool_rate"`
+	CommunityPoolRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=community_pool_rate,json=communityPoolRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_rate" yaml:"community_pool_rate"`
+	ReserveRate       cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=reserve_rate,json=reserveRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reserve_rate" yaml:"reserve_rate"`
+	// reserve_account is the destination of the reserve share.
+	ReserveAccount string `protobuf:"bytes,5,opt,name=reserve_account,json=reserveAccount,proto3" json:"reserve_account,omitempty"`
+}
+
+func (m *DistributionSource) Reset()         { *m = DistributionSource{} }
+func (m *DistributionSource) String() string { return proto.CompactTextString(m) }
+func (*DistributionSource) ProtoMessage()    {}
+func (*DistributionSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cce4bfd3ebfaf11e, []int{1}
+}
+func (m *DistributionSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionSource.Merge(m, src)
+}
+func (m *DistributionSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionSource proto.InternalMessageInfo
+
+func (m *DistributionSource) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *DistributionSource) GetReserveAccount() string {
+	if m != nil {
+		return m.ReserveAccount
+	}
+	return ""
+}
+
+// DistributionRecord defines the rewards distributed at a block height.
+type DistributionRecord struct {
+	// height is the block height of the distribution
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// fee_pool is the amount sent to the reward pool
+	FeePool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_pool,json=feePool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_pool"`
+	// community_pool is the amount sent to the community pool
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool"`
+	// reserve is the amount sent to the reserve account
+	Reserve github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=reserve,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve"`
+	// fee_collector is the amount dripped from the reward pool to the fee
+	// collector
+	FeeCollector github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee_collector,json=feeCollector,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_collector"`
+}
+
+func (m *DistributionRecord) Reset()         { *m = DistributionRecord{} }
+func (m *DistributionRecord) String() string { return proto.CompactTextString(m) }
+func (*DistributionRecord) ProtoMessage()    {}
+func (*DistributionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cce4bfd3ebfaf11e, []int{2}
+}
+func (m *DistributionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionRecord.Merge(m, src)
+}
+func (m *DistributionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionRecord proto.InternalMessageInfo
+
+func (m *DistributionRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DistributionRecord) GetFeePool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeePool
+	}
+	return nil
+}
+
+func (m *DistributionRecord) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *DistributionRecord) GetReserve() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Reserve
+	}
+	return nil
+}
+
+func (m *DistributionRecord) GetFeeCollector() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return nil
+}
+
+// PendingReserve defines the reserve rewards which could not be sent to the
+// reserve account. They are kept in the reserve escrow account and the
+// transfer is retried with an exponential backoff until it succeeds or the
+// governance resolves the pending reserve.
+type PendingReserve struct {
+	// account is the distribution source account holding the rewards.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// reserve_account is the destination of the rewards.
+	ReserveAccount string `protobuf:"bytes,2,opt,name=reserve_account,json=reserveAccount,proto3" json:"reserve_account,omitempty"`
+	// amount is the amount waiting to be sent to the reserve account.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// retries is the number of failed retries of the transfer.
+	Retries uint64 `protobuf:"varint,4,opt,name=retries,proto3" json:"retries,omitempty"`
+	// next_retry_height is the block height from which the transfer is retried.
+	NextRetryHeight int64 `protobuf:"varint,5,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
+}
+
+func (m *PendingReserve) Reset()         { *m = PendingReserve{} }
+func (m *PendingReserve) String() string { return proto.CompactTextString(m) }
+func (*PendingReserve) ProtoMessage()    {}
+func (*PendingReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cce4bfd3ebfaf11e, []int{3}
+}
+func (m *PendingReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingReserve.Merge(m, src)
+}
+func (m *PendingReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingReserve proto.InternalMessageInfo
+
+func (m *PendingReserve) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *PendingReserve) GetReserveAccount() string {
+	if m != nil {
+		return m.ReserveAccount
+	}
+	return ""
+}
+
+func (m *PendingReserve) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PendingReserve) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *PendingReserve) GetNextRetryHeight() int64 {
+	if m != nil {
+		return m.NextRetryHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("xpla.reward.v1beta1.DripMode", DripMode_name, DripMode_value)
 	proto.RegisterType((*Params)(nil), "xpla.reward.v1beta1.Params")
+	proto.RegisterType((*DistributionSource)(nil), "xpla.reward.v1beta1.DistributionSource")
+	proto.RegisterType((*DistributionRecord)(nil), "xpla.reward.v1beta1.DistributionRecord")
+	proto.RegisterType((*PendingReserve)(nil), "xpla.reward.v1beta1.PendingReserve")
 }
 
 func init() { proto.RegisterFile("xpla/reward/v1beta1/reward.proto", fileDescriptor_cce4bfd3ebfaf11e) }
 
 var fileDescriptor_cce4bfd3ebfaf11e = []byte{
-	// 386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3f, 0x4f, 0xea, 0x50,
-	0x18, 0xc6, 0xdb, 0xcb, 0xbd, 0x24, 0xb7, 0xfe, 0x0b, 0x85, 0x04, 0xc4, 0xa4, 0x25, 0x0c, 0x6a,
-	0x18, 0xda, 0x10, 0x37, 0x16, 0x91, 0x10, 0x27, 0x07, 0xc2, 0xe8, 0x42, 0x4e, 0xdb, 0x43, 0x69,
-	0xec, 0xe1, 0x6d, 0x4e, 0x4f, 0x2b, 0xfd, 0x0a, 0x4e, 0x8e, 0x8e, 0x8e, 0x8e, 0x7c, 0x0c, 0x46,
-	0xdc, 0x8c, 0x03, 0x31, 0x30, 0xb0, 0xfb, 0x09, 0x4c, 0x7b, 0x4a, 0xc5, 0xc4, 0x81, 0xa5, 0x69,
-	0x9f, 0xf7, 0x79, 0x9e, 0x5f, 0x9a, 0xf7, 0x95, 0x6a, 0x13, 0xcf, 0x45, 0x3a, 0xc5, 0xf7, 0x88,
-	0x5a, 0x7a, 0xd8, 0x34, 0x30, 0x43, 0xcd, 0xf4, 0x53, 0xf3, 0x28, 0x30, 0x90, 0x8b, 0xb1, 0x43,
-	0x4b, 0xa5, 0xd4, 0x51, 0x2d, 0xd9, 0x60, 0x43, 0x32, 0xd7, 0xe3, 0x37, 0x6e, 0xad, 0x16, 0x10,
-	0x71, 0xc6, 0xa0, 0x27, 0x4f, 0x2e, 0xd5, 0x5f, 0x73, 0x52, 0xbe, 0x87, 0x28, 0x22, 0xbe, 0x6c,
-	0x49, 0x07, 0x43, 0x8c, 0x07, 0x1e, 0x80, 0x3b, 0xa0, 0x88, 0xe1, 0x8a, 0x58, 0x13, 0xcf, 0xff,
-	0x77, 0xda, 0xb3, 0x85, 0x2a, 0xbc, 0x2f, 0xd4, 0x13, 0x13, 0x7c, 0x02, 0xbe, 0x6f, 0xdd, 0x69,
-	0x0e, 0xe8, 0x04, 0xb1, 0x91, 0x76, 0x83, 0x6d, 0x64, 0x46, 0x5d, 0x6c, 0x7e, 0x2e, 0xd4, 0x52,
-	0x84, 0x88, 0xdb, 0xaa, 0xff, 0x68, 0xa8, 0xbf, 0xac, 0xa7, 0x0d, 0xb1, 0xbf, 0x37, 0xc4, 0xb8,
-	0x07, 0xe0, 0xf6, 0x11, 0xc3, 0x72, 0x28, 0x15, 0x4d, 0x20, 0x24, 0x18, 0x3b, 0x2c, 0xda, 0x62,
-	0xfd, 0x49, 0x58, 0xd7, 0xbb, 0xb1, 0xaa, 0x9c, 0xf5, 0x4b, 0x4f, 0x4a, 0x2c, 0x64, 0xa3, 0x8c,
-	0x6b, 0x48, 0xfb, 0x14, 0xfb, 0x98, 0x86, 0x98, 0x03, 0x73, 0x09, 0xf0, 0x72, 0x37, 0x60, 0x91,
-	0x03, 0xb7, 0x0b, 0x36, 0xff, 0x96, 0x6a, 0x09, 0xe3, 0x4c, 0x3a, 0xda, 0x58, 0x90, 0x69, 0x42,
-	0x30, 0x66, 0x95, 0xbf, 0x31, 0xa6, 0x7f, 0x98, 0xca, 0x57, 0x5c, 0x95, 0x5b, 0xd2, 0x31, 0x5f,
-	0xd8, 0xc0, 0x72, 0x7c, 0x46, 0x1d, 0x23, 0x60, 0xdf, 0x91, 0x7f, 0x49, 0xa4, 0xcc, 0x0d, 0xdd,
-	0x6c, 0x9e, 0x66, 0x5b, 0xb5, 0xa7, 0x67, 0x55, 0x78, 0x58, 0x4f, 0x1b, 0xe5, 0x78, 0xf1, 0x16,
-	0x0e, 0xf5, 0xc9, 0xe6, 0x3e, 0xf8, 0x22, 0x3b, 0xed, 0xd9, 0x52, 0x11, 0xe7, 0x4b, 0x45, 0xfc,
-	0x58, 0x2a, 0xe2, 0xe3, 0x4a, 0x11, 0xe6, 0x2b, 0x45, 0x78, 0x5b, 0x29, 0xc2, 0xed, 0xa9, 0xed,
-	0xb0, 0x51, 0x60, 0x68, 0x26, 0x10, 0x3d, 0x4b, 0xc7, 0x07, 0x96, 0x55, 0xb0, 0xc8, 0xc3, 0xbe,
-	0x91, 0x4f, 0x8e, 0xe3, 0xe2, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x83, 0xc7, 0xf8, 0x08, 0x7e, 0x02,
-	0x00, 0x00,
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0x6e, 0xd2, 0x4c, 0x77, 0xd3, 0x64, 0xd2, 0xa5, 0xde, 0x20, 0x12, 0x2b, 0x07,
+	0x1a, 0x15, 0x11, 0x6b, 0x8b, 0xb8, 0x54, 0xfc, 0xd8, 0x64, 0x13, 0x68, 0xa5, 0x6c, 0x37, 0x32,
+	0x3d, 0x20, 0x84, 0x64, 0x4d, 0xec, 0x49, 0x32, 0xd4, 0xf6, 0x44, 0xe3, 0x49, 0x37, 0xb9, 0x70,
+	0xe1, 0x82, 0x7a, 0xe2, 0x08, 0x87, 0x0a, 0x24, 0x2e, 0x88, 0xd3, 0xfe, 0x17, 0xec, 0x71, 0x8f,
+	0x88, 0x43, 0x41, 0xed, 0x61, 0xef, 0x48, 0xdc, 0x91, 0x67, 0x6c, 0x27, 0x69, 0x83, 0x54, 0x69,
+	0xc9, 0x25, 0xf1, 0xbc, 0xf7, 0xbd, 0xf7, 0xcd, 0xbc, 0xf7, 0xbe, 0xb1, 0x81, 0x36, 0x19, 0x39,
+	0x48, 0x67, 0xf8, 0x19, 0x62, 0xb6, 0x7e, 0xfa, 0xb0, 0x87, 0x39, 0x7a, 0x18, 0x2e, 0xeb, 0x23,
+	0x46, 0x39, 0x85, 0xc5, 0x00, 0x51, 0x0f, 0x4d, 0x21, 0xa2, 0xb4, 0x35, 0xa0, 0x03, 0x2a, 0xfc,
+	0x7a, 0xf0, 0x24, 0xa1, 0xa5, 0x02, 0x72, 0x89, 0x47, 0x75, 0xf1, 0x1b, 0x9a, 0xca, 0x16, 0xf5,
+	0x5d, 0xea, 0xeb, 0x3d, 0xe4, 0xe3, 0x38, 0xbf, 0x45, 0x89, 0x27, 0xfd, 0xd5, 0xdf, 0x32, 0x20,
+	0xdd, 0x45, 0x0c, 0xb9, 0x3e, 0xec, 0x83, 0x7b, 0x7d, 0x8c, 0xcd, 0x11, 0xa5, 0x8e, 0xc9, 0x10,
+	0xc7, 0xaa, 0xa2, 0x29, 0xb5, 0x6c, 0xb3, 0xf9, 0xe2, 0xa2, 0x92, 0xf8, 0xe3, 0xa2, 0xf2, 0xa6,
+	0xcc, 0xe4, 0xdb, 0x27, 0x75, 0x42, 0x75, 0x17, 0xf1, 0x61, 0xbd, 0x83, 0x07, 0xc8, 0x9a, 0xb6,
+	0xb0, 0xf5, 0xf7, 0x45, 0x65, 0x6b, 0x8a, 0x5c, 0x67, 0xbf, 0xba, 0x90, 0xa1, 0xfa, 0xcb, 0xab,
+	0xe7, 0xbb, 0x8a, 0xaa, 0x18, 0x1b, 0x7d, 0x8c, 0xbb, 0x94, 0x3a, 0x06, 0xe2, 0x18, 0x4e, 0x40,
+	0xd1, 0xa2, 0xae, 0x3b, 0xf6, 0x08, 0x9f, 0xce, 0xb1, 0x25, 0x05, 0xdb, 0xc1, 0xed, 0xd8, 0x4a,
+	0x92, 0x6d, 0x49, 0x9e, 0x98, 0xb3, 0x10, 0x3b, 0x63, 0x66, 0x1b, 0xdc, 0x65, 0xd8, 0xc7, 0xec,
+	0x14, 0x4b, 0xca, 0x94, 0xa0, 0x6c, 0xdc, 0x8e, 0xb2, 0x28, 0x29, 0xe7, 0x13, 0xcc, 0xce, 0x17,
+	0x5a, 0x05, 0xcb, 0x3b, 0x60, 0x33, 0x02, 0x21, 0xcb, 0xa2, 0x63, 0x8f, 0xab, 0x6b, 0x82, 0x28,
+	0xa9, 0x2a, 0x46, 0x2e, 0x74, 0x35, 0xa4, 0x07, 0x7e, 0x04, 0x1e, 0xc8, 0xd6, 0x9a, 0x36, 0xf1,
+	0x39, 0x23, 0xbd, 0x31, 0x9f, 0x85, 0xdd, 0x89, 0xc3, 0xb6, 0x25, 0xa8, 0x15, 0x63, 0xa2, 0xf8,
+	0x0f, 0x40, 0x29, 0x0e, 0x24, 0xd4, 0x33, 0x87, 0xc4, 0xe7, 0x94, 0x4d, 0x4d, 0x87, 0xb8, 0x84,
+	0xab, 0x69, 0x4d, 0xa9, 0xad, 0x19, 0xea, 0x3c, 0xe2, 0x40, 0x02, 0x3a, 0x81, 0x1f, 0xee, 0x83,
+	0xac, 0xcd, 0xc8, 0xc8, 0x74, 0xa9, 0x8d, 0xd5, 0x8c, 0xa6, 0xd4, 0x72, 0x7b, 0x6f, 0xd5, 0x97,
+	0xcc, 0x5b, 0xbd, 0xc5, 0xc8, 0xe8, 0x09, 0xb5, 0xb1, 0xb1, 0x6e, 0x87, 0x4f, 0xf0, 0x6b, 0x00,
+	0x1d, 0xe2, 0x61, 0xc4, 0x4c, 0x91, 0x02, 0xb9, 0x62, 0xcb, 0xeb, 0x5a, 0xaa, 0xb6, 0xb1, 0xf7,
+	0xa0, 0x2e, 0x6b, 0x59, 0x0f, 0xc6, 0x2e, 0x4e, 0xf2, 0x98, 0x12, 0xaf, 0xf9, 0x7e, 0x50, 0xed,
+	0x5f, 0xff, 0xac, 0xd4, 0x06, 0x84, 0x0f, 0xc7, 0xbd, 0xba, 0x45, 0x5d, 0x3d, 0x9c, 0x51, 0xf9,
+	0xf7, 0xae, 0x6f, 0x9f, 0xe8, 0x7c, 0x3a, 0xc2, 0xbe, 0x08, 0xf0, 0x45, 0x8d, 0x8d, 0xbc, 0xe4,
+	0x0a, 0xf6, 0xd1, 0x10, 0x4c, 0xf0, 0x4b, 0x00, 0x38, 0x62, 0x03, 0xcc, 0x4d, 0x34, 0x62, 0x6a,
+	0x56, 0x94, 0xea, 0xc3, 0xdb, 0xb5, 0xb2, 0x20, 0x5b, 0x39, 0x0b, 0x97, 0x8d, 0x34, 0xb2, 0xd2,
+	0xd2, 0x18, 0x31, 0x88, 0xc1, 0xd6, 0x42, 0x5d, 0x7d, 0x3a, 0x66, 0x16, 0xf6, 0x55, 0x20, 0xce,
+	0xb7, 0xb3, 0xbc, 0x48, 0x73, 0x01, 0x9f, 0x09, 0x7c, 0x33, 0x1b, 0x6c, 0x48, 0x26, 0x2f, 0xda,
+	0x37, 0xdc, 0x3e, 0xdc, 0x01, 0x9b, 0xfd, 0xb1, 0x67, 0xa3, 0x9e, 0x83, 0x4d, 0x1b, 0x7b, 0xd4,
+	0xf5, 0xd5, 0x0d, 0x2d, 0x55, 0xcb, 0x1a, 0xb9, 0xc8, 0xdc, 0x12, 0xd6, 0x7d, 0xed, 0xfb, 0x9f,
+	0x2a, 0x89, 0xb3, 0x57, 0xcf, 0x77, 0xb7, 0x03, 0x66, 0x1b, 0x9f, 0xea, 0x93, 0xe8, 0xd6, 0x90,
+	0xf2, 0xad, 0xfe, 0x90, 0x02, 0xf0, 0xe6, 0x0e, 0xa0, 0x0a, 0x32, 0xd1, 0x38, 0x09, 0x3d, 0x1b,
+	0xd1, 0x12, 0xda, 0xd7, 0xf5, 0x2e, 0x15, 0xf8, 0xe8, 0x75, 0xf5, 0xbe, 0xa8, 0xf6, 0xd3, 0xe5,
+	0x6a, 0x97, 0xd2, 0xfb, 0xe4, 0xff, 0x51, 0xfb, 0x32, 0xad, 0xf7, 0xae, 0x69, 0x5d, 0x4a, 0xf0,
+	0xe3, 0xd7, 0xd4, 0xfa, 0xa2, 0xd2, 0x77, 0x6e, 0x2a, 0x5d, 0x48, 0xf6, 0xba, 0xca, 0xab, 0xff,
+	0x5c, 0xeb, 0x8d, 0x81, 0x2d, 0xca, 0x6c, 0xf8, 0x06, 0x48, 0x0f, 0x31, 0x19, 0x0c, 0x65, 0x6b,
+	0x52, 0x46, 0xb8, 0x82, 0x27, 0x60, 0x3d, 0xaa, 0xab, 0x9a, 0x5c, 0x91, 0xa0, 0x32, 0x61, 0x93,
+	0xe0, 0x33, 0x90, 0x5b, 0x2c, 0xac, 0x9a, 0x5a, 0x11, 0xe5, 0xbd, 0x85, 0x2e, 0xc1, 0xaf, 0x40,
+	0x26, 0x2c, 0x93, 0xba, 0xb6, 0xaa, 0x43, 0x86, 0x04, 0x70, 0x2c, 0x67, 0xdd, 0xa2, 0x8e, 0x83,
+	0x2d, 0x4e, 0x99, 0x7a, 0x67, 0x45, 0x8c, 0x77, 0xfb, 0x18, 0x3f, 0x8e, 0x58, 0xaa, 0xdf, 0x24,
+	0x41, 0xae, 0x8b, 0x3d, 0x9b, 0x78, 0x03, 0x23, 0xdc, 0xc9, 0x7f, 0xeb, 0x71, 0xc9, 0x34, 0x25,
+	0x97, 0x4d, 0x13, 0x1c, 0x82, 0x74, 0x78, 0xdb, 0xae, 0xaa, 0x53, 0x61, 0xfe, 0x60, 0xb3, 0x0c,
+	0x73, 0x46, 0xb0, 0x2f, 0xf4, 0xb3, 0x66, 0x44, 0x4b, 0xb8, 0x0b, 0x0a, 0x1e, 0x9e, 0x70, 0x33,
+	0x58, 0x4f, 0xcd, 0x70, 0x8a, 0xef, 0x88, 0x29, 0xde, 0x0c, 0x1c, 0x46, 0x60, 0x3f, 0x10, 0xe6,
+	0xdd, 0x1f, 0x15, 0xb0, 0x1e, 0xbd, 0x40, 0xe0, 0x1e, 0xb8, 0xdf, 0x32, 0x0e, 0xbb, 0xe6, 0x93,
+	0xa7, 0xad, 0xb6, 0xd9, 0xfe, 0xbc, 0xfb, 0xf4, 0xa8, 0x7d, 0x74, 0x7c, 0xd8, 0xe8, 0xe4, 0x13,
+	0xa5, 0xed, 0xb3, 0x73, 0xad, 0x18, 0x01, 0xdb, 0x93, 0x11, 0xf5, 0xb0, 0xc7, 0x09, 0x72, 0x60,
+	0x0d, 0xe4, 0x67, 0x31, 0x9d, 0xc3, 0xa3, 0x76, 0xc3, 0xc8, 0x2b, 0x25, 0x78, 0x76, 0xae, 0xe5,
+	0x22, 0x78, 0x47, 0xbc, 0x1e, 0xa0, 0x0e, 0xb6, 0x66, 0xc8, 0xe3, 0x86, 0xf1, 0x69, 0xfb, 0xd8,
+	0x6c, 0x74, 0x8d, 0x7c, 0xb2, 0x74, 0xff, 0xec, 0x5c, 0x2b, 0x44, 0xe8, 0xe3, 0xe8, 0x9e, 0x2f,
+	0xad, 0x7d, 0xfb, 0x73, 0x39, 0xd1, 0x7c, 0xf4, 0xe2, 0xb2, 0xac, 0xbc, 0xbc, 0x2c, 0x2b, 0x7f,
+	0x5d, 0x96, 0x95, 0xef, 0xae, 0xca, 0x89, 0x97, 0x57, 0xe5, 0xc4, 0xef, 0x57, 0xe5, 0xc4, 0x17,
+	0x6f, 0xcf, 0x15, 0x2e, 0xbe, 0x79, 0x83, 0x4f, 0xb6, 0xf8, 0xfa, 0x15, 0xc5, 0xeb, 0xa5, 0xc5,
+	0xe7, 0xd4, 0x7b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x76, 0xe5, 0x83, 0xf5, 0xd0, 0x09, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -135,6 +497,63 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FundableDenoms) > 0 {
+		for iNdEx := len(m.FundableDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FundableDenoms[iNdEx])
+			copy(dAtA[i:], m.FundableDenoms[iNdEx])
+			i = encodeVarintReward(dAtA, i, uint64(len(m.FundableDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DistributionSources) > 0 {
+		for iNdEx := len(m.DistributionSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.TargetApr.Size()
+		i -= size
+		if _, err := m.TargetApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.LinearDripAmount) > 0 {
+		for iNdEx := len(m.LinearDripAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LinearDripAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.DripMode != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.DripMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DistributionHistoryLimit != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.DistributionHistoryLimit))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.RewardDistributeAccount) > 0 {
 		i -= len(m.RewardDistributeAccount)
 		copy(dAtA[i:], m.RewardDistributeAccount)
@@ -175,54 +594,984 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.FeePoolRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintReward(dAtA, i, uint64(size))
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReserveAccount) > 0 {
+		i -= len(m.ReserveAccount)
+		copy(dAtA[i:], m.ReserveAccount)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.ReserveAccount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.ReserveRate.Size()
+		i -= size
+		if _, err := m.ReserveRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CommunityPoolRate.Size()
+		i -= size
+		if _, err := m.CommunityPoolRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FeePoolRate.Size()
+		i -= size
+		if _, err := m.FeePoolRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeCollector) > 0 {
+		for iNdEx := len(m.FeeCollector) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCollector[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Reserve) > 0 {
+		for iNdEx := len(m.Reserve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeePool) > 0 {
+		for iNdEx := len(m.FeePool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRetryHeight != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.NextRetryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Retries != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ReserveAccount) > 0 {
+		i -= len(m.ReserveAccount)
+		copy(dAtA[i:], m.ReserveAccount)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.ReserveAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeePoolRate.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.CommunityPoolRate.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.ReserveRate.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = len(m.ReserveAccount)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	l = len(m.RewardDistributeAccount)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	if m.DistributionHistoryLimit != 0 {
+		n += 1 + sovReward(uint64(m.DistributionHistoryLimit))
+	}
+	if m.DripMode != 0 {
+		n += 1 + sovReward(uint64(m.DripMode))
+	}
+	if len(m.LinearDripAmount) > 0 {
+		for _, e := range m.LinearDripAmount {
+			l = e.Size()
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	l = m.TargetApr.Size()
+	n += 1 + l + sovReward(uint64(l))
+	if len(m.DistributionSources) > 0 {
+		for _, e := range m.DistributionSources {
+			l = e.Size()
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	if len(m.FundableDenoms) > 0 {
+		for _, s := range m.FundableDenoms {
+			l = len(s)
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	l = m.FeePoolRate.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.CommunityPoolRate.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.ReserveRate.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = len(m.ReserveAccount)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	return n
+}
+
+func (m *DistributionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovReward(uint64(m.Height))
+	}
+	if len(m.FeePool) > 0 {
+		for _, e := range m.FeePool {
+			l = e.Size()
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	if len(m.Reserve) > 0 {
+		for _, e := range m.Reserve {
+			l = e.Size()
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	if len(m.FeeCollector) > 0 {
+		for _, e := range m.FeeCollector {
+			l = e.Size()
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	l = len(m.ReserveAccount)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	if m.Retries != 0 {
+		n += 1 + sovReward(uint64(m.Retries))
+	}
+	if m.NextRetryHeight != 0 {
+		n += 1 + sovReward(uint64(m.NextRetryHeight))
+	}
+	return n
+}
+
+func sovReward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReward(x uint64) (n int) {
+	return sovReward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePoolRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePoolRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributeAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDistributeAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionHistoryLimit", wireType)
+			}
+			m.DistributionHistoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionHistoryLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DripMode", wireType)
+			}
+			m.DripMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DripMode |= DripMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearDripAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinearDripAmount = append(m.LinearDripAmount, types.Coin{})
+			if err := m.LinearDripAmount[len(m.LinearDripAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionSources = append(m.DistributionSources, DistributionSource{})
+			if err := m.DistributionSources[len(m.DistributionSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundableDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundableDenoms = append(m.FundableDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePoolRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePoolRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintReward(dAtA []byte, offset int, v uint64) int {
-	offset -= sovReward(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.FeePoolRate.Size()
-	n += 1 + l + sovReward(uint64(l))
-	l = m.CommunityPoolRate.Size()
-	n += 1 + l + sovReward(uint64(l))
-	l = m.ReserveRate.Size()
-	n += 1 + l + sovReward(uint64(l))
-	l = len(m.ReserveAccount)
-	if l > 0 {
-		n += 1 + l + sovReward(uint64(l))
-	}
-	l = len(m.RewardDistributeAccount)
-	if l > 0 {
-		n += 1 + l + sovReward(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovReward(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozReward(x uint64) (n int) {
-	return sovReward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DistributionRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -245,17 +1594,36 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePoolRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReward
@@ -265,31 +1633,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthReward
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthReward
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeePoolRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeePool = append(m.FeePool, types.Coin{})
+			if err := m.FeePool[len(m.FeePool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReward
@@ -299,31 +1667,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthReward
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthReward
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPoolRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReward
@@ -333,29 +1701,113 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthReward
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthReward
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reserve = append(m.Reserve, types.Coin{})
+			if err := m.Reserve[len(m.Reserve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = append(m.FeeCollector, types.Coin{})
+			if err := m.FeeCollector[len(m.FeeCollector)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -383,11 +1835,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReserveAccount = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributeAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -415,8 +1867,80 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardDistributeAccount = string(dAtA[iNdEx:postIndex])
+			m.ReserveAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
+			}
+			m.NextRetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReward(dAtA[iNdEx:])
@@ -206,50 +206,281 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgWithdrawRewardPool is the Msg/WithdrawRewardPool request type for
+// withdrawing coins from the reward pool.
+type MsgWithdrawRewardPool struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// recipient is the account receiving the coins. The coins are sent to the
+	// community pool if it is empty.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount withdrawn from the reward pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawRewardPool) Reset()         { *m = MsgWithdrawRewardPool{} }
+func (m *MsgWithdrawRewardPool) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRewardPool) ProtoMessage()    {}
+func (*MsgWithdrawRewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ab6178765eaaaf2, []int{4}
+}
+func (m *MsgWithdrawRewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawRewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRewardPool.Merge(m, src)
+}
+func (m *MsgWithdrawRewardPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRewardPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRewardPool proto.InternalMessageInfo
+
+func (m *MsgWithdrawRewardPool) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgWithdrawRewardPool) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgWithdrawRewardPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgWithdrawRewardPoolResponse defines the response structure for executing
+// a MsgWithdrawRewardPool message.
+type MsgWithdrawRewardPoolResponse struct {
+}
+
+func (m *MsgWithdrawRewardPoolResponse) Reset()         { *m = MsgWithdrawRewardPoolResponse{} }
+func (m *MsgWithdrawRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRewardPoolResponse) ProtoMessage()    {}
+func (*MsgWithdrawRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ab6178765eaaaf2, []int{5}
+}
+func (m *MsgWithdrawRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRewardPoolResponse.Merge(m, src)
+}
+func (m *MsgWithdrawRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRewardPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRewardPoolResponse proto.InternalMessageInfo
+
+// MsgResolvePendingReserve is the Msg/ResolvePendingReserve request type for
+// sending a pending reserve out of the reserve escrow account.
+type MsgResolvePendingReserve struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// account is the distribution source account of the pending reserve.
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// reserve_account is the reserve account of the pending reserve.
+	ReserveAccount string `protobuf:"bytes,3,opt,name=reserve_account,json=reserveAccount,proto3" json:"reserve_account,omitempty"`
+	// recipient is the account receiving the pending reserve. The pending
+	// reserve is sent to the community pool if it is empty.
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgResolvePendingReserve) Reset()         { *m = MsgResolvePendingReserve{} }
+func (m *MsgResolvePendingReserve) String() string { return proto.CompactTextString(m) }
+func (*MsgResolvePendingReserve) ProtoMessage()    {}
+func (*MsgResolvePendingReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ab6178765eaaaf2, []int{6}
+}
+func (m *MsgResolvePendingReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolvePendingReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolvePendingReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolvePendingReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolvePendingReserve.Merge(m, src)
+}
+func (m *MsgResolvePendingReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolvePendingReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolvePendingReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolvePendingReserve proto.InternalMessageInfo
+
+func (m *MsgResolvePendingReserve) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResolvePendingReserve) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MsgResolvePendingReserve) GetReserveAccount() string {
+	if m != nil {
+		return m.ReserveAccount
+	}
+	return ""
+}
+
+func (m *MsgResolvePendingReserve) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// MsgResolvePendingReserveResponse defines the response structure for
+// executing a MsgResolvePendingReserve message.
+type MsgResolvePendingReserveResponse struct {
+}
+
+func (m *MsgResolvePendingReserveResponse) Reset()         { *m = MsgResolvePendingReserveResponse{} }
+func (m *MsgResolvePendingReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolvePendingReserveResponse) ProtoMessage()    {}
+func (*MsgResolvePendingReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ab6178765eaaaf2, []int{7}
+}
+func (m *MsgResolvePendingReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolvePendingReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolvePendingReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolvePendingReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolvePendingReserveResponse.Merge(m, src)
+}
+func (m *MsgResolvePendingReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolvePendingReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolvePendingReserveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolvePendingReserveResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgFundRewardPool)(nil), "xpla.reward.v1beta1.MsgFundRewardPool")
 	proto.RegisterType((*MsgFundRewardPoolResponse)(nil), "xpla.reward.v1beta1.MsgFundRewardPoolResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "xpla.reward.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "xpla.reward.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgWithdrawRewardPool)(nil), "xpla.reward.v1beta1.MsgWithdrawRewardPool")
+	proto.RegisterType((*MsgWithdrawRewardPoolResponse)(nil), "xpla.reward.v1beta1.MsgWithdrawRewardPoolResponse")
+	proto.RegisterType((*MsgResolvePendingReserve)(nil), "xpla.reward.v1beta1.MsgResolvePendingReserve")
+	proto.RegisterType((*MsgResolvePendingReserveResponse)(nil), "xpla.reward.v1beta1.MsgResolvePendingReserveResponse")
 }
 
 func init() { proto.RegisterFile("xpla/reward/v1beta1/tx.proto", fileDescriptor_9ab6178765eaaaf2) }
 
 var fileDescriptor_9ab6178765eaaaf2 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0x51, 0x11, 0x29, 0xd7, 0x0a, 0x54, 0x53, 0xa9, 0xb1, 0x8b, 0x9c, 0x28, 0x42, 0x55,
-	0x14, 0x51, 0x5b, 0x49, 0x11, 0x43, 0x07, 0x04, 0x41, 0xea, 0x16, 0xa9, 0x0a, 0x62, 0x61, 0xa9,
-	0xce, 0xf1, 0xe9, 0x62, 0x11, 0xfb, 0x2c, 0xdf, 0x25, 0x24, 0x1b, 0x62, 0x42, 0x4c, 0xcc, 0x4c,
-	0x1d, 0x11, 0x53, 0x06, 0x66, 0xe6, 0x8e, 0x15, 0x13, 0x62, 0x00, 0x94, 0x0c, 0xe1, 0x8f, 0x60,
-	0x40, 0xf7, 0xc3, 0x6e, 0x49, 0x82, 0x9a, 0x25, 0x17, 0x7f, 0xef, 0xdd, 0xf7, 0xbd, 0xf7, 0xee,
-	0x0e, 0xde, 0x1d, 0x25, 0x7d, 0xe4, 0xa5, 0xf8, 0x15, 0x4a, 0x03, 0x6f, 0xd8, 0xf0, 0x31, 0x47,
-	0x0d, 0x8f, 0x8f, 0xdc, 0x24, 0xa5, 0x9c, 0x9a, 0x77, 0x04, 0xea, 0x2a, 0xd4, 0xd5, 0xa8, 0xbd,
-	0x43, 0x28, 0xa1, 0x12, 0xf7, 0xc4, 0x3f, 0x45, 0xb5, 0x9d, 0x2e, 0x65, 0x11, 0x65, 0x9e, 0x8f,
-	0x18, 0xce, 0x1b, 0x75, 0x69, 0x18, 0x6b, 0x7c, 0x57, 0xe3, 0x11, 0x23, 0xde, 0xb0, 0x21, 0x16,
-	0x0d, 0x58, 0x0a, 0x38, 0x55, 0x1d, 0xd5, 0x87, 0x86, 0x2a, 0xab, 0xc4, 0x69, 0x35, 0x8a, 0xb1,
-	0x8d, 0xa2, 0x30, 0xa6, 0x9e, 0xfc, 0x55, 0xa5, 0xea, 0x1f, 0x00, 0xb7, 0xdb, 0x8c, 0x1c, 0x0f,
-	0xe2, 0xa0, 0x23, 0xa9, 0x27, 0x94, 0xf6, 0xcd, 0x31, 0x2c, 0xa0, 0x88, 0x0e, 0x62, 0x5e, 0x02,
-	0x95, 0x8d, 0xda, 0x66, 0xd3, 0x72, 0xf5, 0x24, 0xa1, 0x37, 0xb3, 0xe6, 0x3e, 0xa5, 0x61, 0xdc,
-	0x3a, 0x3e, 0xff, 0x51, 0x36, 0x3e, 0xfd, 0x2c, 0xd7, 0x48, 0xc8, 0x7b, 0x03, 0xdf, 0xed, 0xd2,
-	0x48, 0xcb, 0xd2, 0xcb, 0x01, 0x0b, 0x5e, 0x7a, 0x7c, 0x9c, 0x60, 0x26, 0x37, 0xb0, 0x0f, 0xf3,
-	0x49, 0x7d, 0xab, 0x8f, 0x09, 0xea, 0x8e, 0x4f, 0x85, 0x63, 0xf6, 0x71, 0x3e, 0xa9, 0x83, 0x8e,
-	0x1e, 0x68, 0x3e, 0x84, 0xc5, 0x00, 0x27, 0x94, 0x85, 0x9c, 0xa6, 0xa5, 0x1b, 0x15, 0x50, 0x2b,
-	0xb6, 0x4a, 0x5f, 0x3f, 0x1f, 0xec, 0x68, 0x01, 0x4f, 0x82, 0x20, 0xc5, 0x8c, 0x3d, 0xe3, 0x69,
-	0x18, 0x93, 0xce, 0x25, 0xf5, 0xe8, 0xc1, 0xdb, 0xb3, 0xb2, 0xf1, 0xfb, 0xac, 0x6c, 0xbc, 0x99,
-	0x4f, 0xea, 0x97, 0xf5, 0x77, 0xf3, 0x49, 0xdd, 0x12, 0xc1, 0x04, 0x78, 0xe8, 0x2d, 0x19, 0xad,
-	0xee, 0x41, 0x6b, 0xa9, 0xd8, 0xc1, 0x2c, 0xa1, 0x31, 0xc3, 0xd5, 0x2f, 0x00, 0xde, 0x6e, 0x33,
-	0xf2, 0x3c, 0x09, 0x10, 0xc7, 0x27, 0x28, 0x45, 0x11, 0x13, 0xf2, 0xd0, 0x80, 0xf7, 0x68, 0x1a,
-	0xf2, 0x71, 0x09, 0x5c, 0x27, 0x2f, 0xa7, 0x9a, 0x8f, 0x60, 0x21, 0x91, 0x1d, 0xa4, 0xa7, 0xcd,
-	0xe6, 0x9e, 0xbb, 0xe2, 0xb2, 0xb8, 0x6a, 0x48, 0xab, 0x28, 0x32, 0xd5, 0xb1, 0xa8, 0x5d, 0x47,
-	0x87, 0xd2, 0x56, 0xde, 0x4f, 0xd8, 0xaa, 0x64, 0xb6, 0x46, 0xd9, 0xa1, 0x2f, 0x88, 0xad, 0x5a,
-	0x70, 0x77, 0xa1, 0x94, 0x79, 0x6b, 0x7e, 0x07, 0x70, 0xa3, 0xcd, 0x88, 0xd9, 0x83, 0xb7, 0x16,
-	0xce, 0x7e, 0x7f, 0xa5, 0xb2, 0xa5, 0x94, 0x6c, 0x77, 0x3d, 0x5e, 0x36, 0xd1, 0xf4, 0xe1, 0xd6,
-	0x3f, 0x49, 0xde, 0xfb, 0xdf, 0xfe, 0xab, 0x2c, 0xfb, 0xfe, 0x3a, 0xac, 0x6c, 0x86, 0x7d, 0xf3,
-	0xb5, 0x08, 0xad, 0xf5, 0xf8, 0x7c, 0xea, 0x80, 0x8b, 0xa9, 0x03, 0x7e, 0x4d, 0x1d, 0xf0, 0x7e,
-	0xe6, 0x18, 0x17, 0x33, 0xc7, 0xf8, 0x36, 0x73, 0x8c, 0x17, 0xfb, 0x57, 0x6e, 0x69, 0x1e, 0x9f,
-	0x78, 0x36, 0x79, 0x86, 0xf2, 0xa6, 0xfa, 0x05, 0xf9, 0x3a, 0x0e, 0xff, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0x70, 0x49, 0x31, 0x07, 0xf1, 0x03, 0x00, 0x00,
+	// 688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0x8d, 0x1b, 0x28, 0xca, 0xb5, 0x6a, 0x55, 0xd3, 0xaa, 0x89, 0x0b, 0x4e, 0x64, 0xa1, 0xaa,
+	0x8a, 0xa8, 0xad, 0xa6, 0x50, 0x89, 0x0e, 0x88, 0x06, 0xa9, 0x5b, 0xa4, 0x2a, 0x08, 0x21, 0xb1,
+	0x54, 0x17, 0xfb, 0xe4, 0x58, 0xc4, 0x3e, 0xeb, 0xee, 0x92, 0x36, 0x03, 0x12, 0x62, 0x42, 0x4c,
+	0xcc, 0x4c, 0x1d, 0x11, 0x53, 0x06, 0xc4, 0x06, 0x73, 0x37, 0x2a, 0x26, 0x26, 0x40, 0xed, 0x10,
+	0xfe, 0x08, 0x06, 0x74, 0xf6, 0xd9, 0x4d, 0x63, 0x87, 0x84, 0x0e, 0x2c, 0x75, 0x73, 0xef, 0x7d,
+	0x3f, 0xde, 0xbb, 0xef, 0xb3, 0xc1, 0x8d, 0x43, 0xbf, 0x05, 0x0d, 0x82, 0x0e, 0x20, 0xb1, 0x8c,
+	0xce, 0x46, 0x03, 0x31, 0xb8, 0x61, 0xb0, 0x43, 0xdd, 0x27, 0x98, 0x61, 0xf9, 0x3a, 0x47, 0xf5,
+	0x10, 0xd5, 0x05, 0xaa, 0x2c, 0xda, 0xd8, 0xc6, 0x01, 0x6e, 0xf0, 0xff, 0x42, 0xaa, 0xa2, 0x9a,
+	0x98, 0xba, 0x98, 0x1a, 0x0d, 0x48, 0x51, 0x9c, 0xc8, 0xc4, 0x8e, 0x27, 0xf0, 0x65, 0x81, 0xbb,
+	0xd4, 0x36, 0x3a, 0x1b, 0xfc, 0x21, 0x80, 0x42, 0x08, 0xec, 0x87, 0x19, 0xc3, 0x1f, 0x02, 0x2a,
+	0xa5, 0x35, 0x27, 0xba, 0x09, 0x19, 0x0b, 0xd0, 0x75, 0x3c, 0x6c, 0x04, 0x7f, 0xc3, 0x23, 0xed,
+	0xb7, 0x04, 0x16, 0x6a, 0xd4, 0xde, 0x6d, 0x7b, 0x56, 0x3d, 0xa0, 0xee, 0x61, 0xdc, 0x92, 0xbb,
+	0x60, 0x1a, 0xba, 0xb8, 0xed, 0xb1, 0xbc, 0x54, 0xca, 0xae, 0xcd, 0x54, 0x0a, 0xba, 0xa8, 0xc4,
+	0xfb, 0x8d, 0xa4, 0xe9, 0x0f, 0xb1, 0xe3, 0x55, 0x77, 0x8f, 0xbf, 0x17, 0x33, 0xef, 0x7f, 0x14,
+	0xd7, 0x6c, 0x87, 0x35, 0xdb, 0x0d, 0xdd, 0xc4, 0xae, 0x68, 0x4b, 0x3c, 0xd6, 0xa9, 0xf5, 0xcc,
+	0x60, 0x5d, 0x1f, 0xd1, 0x20, 0x80, 0xbe, 0xed, 0xf7, 0xca, 0xb3, 0x2d, 0x64, 0x43, 0xb3, 0xbb,
+	0xcf, 0x15, 0xd3, 0x77, 0xfd, 0x5e, 0x59, 0xaa, 0x8b, 0x82, 0xf2, 0x16, 0xc8, 0x59, 0xc8, 0xc7,
+	0xd4, 0x61, 0x98, 0xe4, 0xa7, 0x4a, 0xd2, 0x5a, 0xae, 0x9a, 0xff, 0xfa, 0x61, 0x7d, 0x51, 0x34,
+	0xb0, 0x63, 0x59, 0x04, 0x51, 0xfa, 0x88, 0x11, 0xc7, 0xb3, 0xeb, 0xe7, 0xd4, 0xed, 0x3b, 0xaf,
+	0x8e, 0x8a, 0x99, 0x5f, 0x47, 0xc5, 0xcc, 0xcb, 0x7e, 0xaf, 0x7c, 0x7e, 0xfe, 0xba, 0xdf, 0x2b,
+	0x17, 0xb8, 0x31, 0x16, 0xea, 0x18, 0x09, 0xa1, 0xda, 0x0a, 0x28, 0x24, 0x0e, 0xeb, 0x88, 0xfa,
+	0xd8, 0xa3, 0x48, 0xfb, 0x2c, 0x81, 0xf9, 0x1a, 0xb5, 0x1f, 0xfb, 0x16, 0x64, 0x68, 0x0f, 0x12,
+	0xe8, 0x52, 0xde, 0x1e, 0x6c, 0xb3, 0x26, 0x26, 0x0e, 0xeb, 0xe6, 0xa5, 0x71, 0xed, 0xc5, 0x54,
+	0xf9, 0x3e, 0x98, 0xf6, 0x83, 0x0c, 0x81, 0xa6, 0x99, 0xca, 0x8a, 0x9e, 0x32, 0x2c, 0x7a, 0x58,
+	0xa4, 0x9a, 0xe3, 0x9e, 0x0a, 0x5b, 0xc2, 0xa8, 0xed, 0xcd, 0x40, 0x56, 0x9c, 0x8f, 0xcb, 0x2a,
+	0x45, 0xb2, 0x0e, 0xa3, 0x4b, 0x1f, 0x6a, 0x56, 0x2b, 0x80, 0xe5, 0xa1, 0xa3, 0x58, 0xdb, 0xa7,
+	0x29, 0xb0, 0x54, 0xa3, 0xf6, 0x13, 0x87, 0x35, 0x2d, 0x02, 0x0f, 0x06, 0xee, 0xfe, 0xb2, 0x0a,
+	0xb7, 0x40, 0x8e, 0x20, 0xd3, 0xf1, 0x1d, 0xe4, 0xb1, 0xf1, 0x17, 0x17, 0x53, 0x07, 0x66, 0x2d,
+	0xfb, 0x9f, 0x67, 0x6d, 0xfb, 0x5e, 0xd2, 0xd4, 0xd5, 0x34, 0x53, 0x93, 0x2e, 0x69, 0x45, 0x70,
+	0x33, 0x15, 0x88, 0x0d, 0xfe, 0x38, 0x05, 0xf2, 0x35, 0x6a, 0xd7, 0x11, 0xc5, 0xad, 0x0e, 0xda,
+	0x43, 0x9e, 0xc5, 0x75, 0x23, 0x8a, 0x48, 0x07, 0x5d, 0xda, 0xe3, 0x0a, 0xb8, 0x06, 0x4d, 0x33,
+	0x30, 0x6b, 0x9c, 0xc3, 0x11, 0x51, 0xde, 0x01, 0xf3, 0x24, 0x2c, 0xbb, 0x1f, 0xc5, 0x66, 0xc7,
+	0xc4, 0xce, 0x89, 0x80, 0x1d, 0x91, 0xe2, 0xc2, 0xd5, 0x5e, 0x99, 0xf8, 0x6a, 0xff, 0x3a, 0xb4,
+	0xa3, 0xbc, 0xd1, 0x34, 0x50, 0x1a, 0x85, 0x45, 0xe6, 0x56, 0xbe, 0x64, 0x41, 0xb6, 0x46, 0x6d,
+	0xb9, 0x09, 0xe6, 0x86, 0xde, 0x5c, 0xab, 0xa9, 0x7b, 0x95, 0xd8, 0x71, 0x45, 0x9f, 0x8c, 0x17,
+	0x55, 0x94, 0x1b, 0x60, 0xf6, 0xc2, 0x7b, 0xe0, 0xd6, 0xa8, 0xf8, 0x41, 0x96, 0x72, 0x7b, 0x12,
+	0x56, 0x5c, 0x83, 0x01, 0x39, 0x65, 0x1f, 0xcb, 0xa3, 0x72, 0x24, 0xb9, 0x4a, 0x65, 0x72, 0x6e,
+	0x5c, 0xf5, 0x39, 0x58, 0x4a, 0x1f, 0xd2, 0xf5, 0x51, 0xc9, 0x52, 0xe9, 0xca, 0xdd, 0x7f, 0xa2,
+	0x47, 0xe5, 0x95, 0xab, 0x2f, 0xf8, 0x4a, 0x56, 0x1f, 0x1c, 0x9f, 0xaa, 0xd2, 0xc9, 0xa9, 0x2a,
+	0xfd, 0x3c, 0x55, 0xa5, 0x37, 0x67, 0x6a, 0xe6, 0xe4, 0x4c, 0xcd, 0x7c, 0x3b, 0x53, 0x33, 0x4f,
+	0x57, 0x07, 0x96, 0x3d, 0x5e, 0x4e, 0xfe, 0xa5, 0x8b, 0x37, 0x34, 0x58, 0xf8, 0xc6, 0x74, 0xf0,
+	0x41, 0xdb, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x97, 0x47, 0x35, 0x60, 0xa4, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -271,6 +502,13 @@ type MsgClient interface {
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// WithdrawRewardPool defines a governance operation for withdrawing coins
+	// from the reward pool to an account or the community pool.
+	WithdrawRewardPool(ctx context.Context, in *MsgWithdrawRewardPool, opts ...grpc.CallOption) (*MsgWithdrawRewardPoolResponse, error)
+	// ResolvePendingReserve defines a governance operation for sending a
+	// pending reserve from the reserve escrow account to another account or the
+	// community pool.
+	ResolvePendingReserve(ctx context.Context, in *MsgResolvePendingReserve, opts ...grpc.CallOption) (*MsgResolvePendingReserveResponse, error)
 }
 
 type msgClient struct {
@@ -299,6 +537,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) WithdrawRewardPool(ctx context.Context, in *MsgWithdrawRewardPool, opts ...grpc.CallOption) (*MsgWithdrawRewardPoolResponse, error) {
+	out := new(MsgWithdrawRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/xpla.reward.v1beta1.Msg/WithdrawRewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolvePendingReserve(ctx context.Context, in *MsgResolvePendingReserve, opts ...grpc.CallOption) (*MsgResolvePendingReserveResponse, error) {
+	out := new(MsgResolvePendingReserveResponse)
+	err := c.cc.Invoke(ctx, "/xpla.reward.v1beta1.Msg/ResolvePendingReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// MsgFundRewardPool defines a method to allow an account to directly
@@ -308,6 +564,13 @@ type MsgServer interface {
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// WithdrawRewardPool defines a governance operation for withdrawing coins
+	// from the reward pool to an account or the community pool.
+	WithdrawRewardPool(context.Context, *MsgWithdrawRewardPool) (*MsgWithdrawRewardPoolResponse, error)
+	// ResolvePendingReserve defines a governance operation for sending a
+	// pending reserve from the reserve escrow account to another account or the
+	// community pool.
+	ResolvePendingReserve(context.Context, *MsgResolvePendingReserve) (*MsgResolvePendingReserveResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -320,6 +583,12 @@ func (*UnimplementedMsgServer) FundRewardPool(ctx context.Context, req *MsgFundR
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawRewardPool(ctx context.Context, req *MsgWithdrawRewardPool) (*MsgWithdrawRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewardPool not implemented")
+}
+func (*UnimplementedMsgServer) ResolvePendingReserve(ctx context.Context, req *MsgResolvePendingReserve) (*MsgResolvePendingReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolvePendingReserve not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -361,6 +630,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawRewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRewardPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.reward.v1beta1.Msg/WithdrawRewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRewardPool(ctx, req.(*MsgWithdrawRewardPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolvePendingReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolvePendingReserve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolvePendingReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.reward.v1beta1.Msg/ResolvePendingReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolvePendingReserve(ctx, req.(*MsgResolvePendingReserve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "xpla.reward.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -373,6 +678,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "WithdrawRewardPool",
+			Handler:    _Msg_WithdrawRewardPool_Handler,
+		},
+		{
+			MethodName: "ResolvePendingReserve",
+			Handler:    _Msg_ResolvePendingReserve_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xpla/reward/v1beta1/tx.proto",
@@ -508,76 +821,621 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgWithdrawRewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgFundRewardPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgWithdrawRewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolvePendingReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolvePendingReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolvePendingReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReserveAccount) > 0 {
+		i -= len(m.ReserveAccount)
+		copy(dAtA[i:], m.ReserveAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReserveAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolvePendingReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolvePendingReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolvePendingReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFundRewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawRewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolvePendingReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReserveAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolvePendingReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgFundRewardPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawRewardPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -600,17 +1458,17 @@ func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundRewardPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawRewardPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -620,29 +1478,27 @@ func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -670,7 +1526,41 @@ func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -693,7 +1583,7 @@ func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawRewardPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -716,10 +1606,10 @@ func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundRewardPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawRewardPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -743,7 +1633,7 @@ func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgResolvePendingReserve) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -766,10 +1656,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolvePendingReserve: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolvePendingReserve: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -806,9 +1696,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -818,24 +1708,87 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -858,7 +1811,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolvePendingReserveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -881,10 +1834,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolvePendingReserveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolvePendingReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
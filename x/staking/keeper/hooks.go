@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	volunteertypes "github.com/xpladev/xpla/x/volunteer/types"
+)
+
+// VolunteerHooks rejects the delegation changes of a volunteer validator by
+// any account other than the validator itself. The hooks are called by the
+// staking keeper, so that the restriction is enforced on every path which
+// delegates, undelegates or redelegates: transactions, authz, ICA host
+// execution and the EVM staking precompile.
+type VolunteerHooks struct {
+	k *Keeper
+}
+
+var _ stakingtypes.StakingHooks = VolunteerHooks{}
+
+// VolunteerHooks returns the staking hooks rejecting the delegations to
+// volunteer validators.
+func (k *Keeper) VolunteerHooks() VolunteerHooks {
+	return VolunteerHooks{k}
+}
+
+// checkVolunteerDelegation returns an error if the validator is a volunteer
+// validator and the delegator is not the validator itself.
+func (h VolunteerHooks) checkVolunteerDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if _, err := h.k.volunteerKeeper.GetVolunteerValidator(ctx, valAddr); err != nil {
+		return nil
+	}
+
+	if delAddr.Equals(valAddr) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(volunteertypes.ErrVolunteerValidatorDelegation, "validator: %s, delegator: %s", valAddr, delAddr)
+}
+
+func (h VolunteerHooks) BeforeDelegationCreated(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.checkVolunteerDelegation(ctx, delAddr, valAddr)
+}
+
+func (h VolunteerHooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.checkVolunteerDelegation(ctx, delAddr, valAddr)
+}
+
+func (h VolunteerHooks) AfterValidatorCreated(_ context.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h VolunteerHooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h VolunteerHooks) AfterValidatorRemoved(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h VolunteerHooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h VolunteerHooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h VolunteerHooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h VolunteerHooks) AfterDelegationModified(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h VolunteerHooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ math.LegacyDec) error {
+	return nil
+}
+
+func (h VolunteerHooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
+	return nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Slash slashes the validator with the power of its tokens instead of the
+// clamped consensus power reported for a volunteer validator.
+func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec) (math.Int, error) {
+	power, err := k.tokenPower(ctx, consAddr, infractionHeight, power)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return k.Keeper.Slash(ctx, consAddr, infractionHeight, power, slashFactor)
+}
+
+// SlashWithInfractionReason slashes the validator with the power of its tokens
+// instead of the clamped consensus power reported for a volunteer validator.
+func (k Keeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec, infraction types.Infraction) (math.Int, error) {
+	power, err := k.tokenPower(ctx, consAddr, infractionHeight, power)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return k.Keeper.SlashWithInfractionReason(ctx, consAddr, infractionHeight, power, slashFactor, infraction)
+}
+
+// tokenPower converts the consensus power reported to CometBFT back to the
+// power of the validator tokens at the infraction height, which undoes the
+// clamp of clampVolunteerPowers for a volunteer validator. The tokens are taken
+// from the historical info of the infraction height. Without it, the reported
+// power is scaled up by the ratio between the current token power and the last
+// reported power, which approximates the clamp at the infraction height. The
+// power of other validators is returned as it is.
+func (k Keeper) tokenPower(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64) (int64, error) {
+	validator, err := k.GetValidatorByConsAddr(ctx, consAddr)
+	if errors.Is(err, types.ErrNoValidatorFound) {
+		// the embedded keeper handles a missing validator
+		return power, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := k.volunteerKeeper.GetVolunteerValidator(ctx, valAddr); err != nil {
+		return power, nil
+	}
+
+	historicalInfo, err := k.GetHistoricalInfo(ctx, infractionHeight)
+	if err == nil {
+		for _, historicalValidator := range historicalInfo.Valset {
+			if historicalValidator.GetOperator() != validator.GetOperator() {
+				continue
+			}
+
+			return max(power, k.TokensToConsensusPower(ctx, historicalValidator.GetTokens())), nil
+		}
+	} else if !errors.Is(err, types.ErrNoHistoricalInfo) {
+		return 0, err
+	}
+
+	reportedPower, err := k.GetLastValidatorPower(ctx, valAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	validatorPower := k.TokensToConsensusPower(ctx, validator.GetTokens())
+	if reportedPower <= 0 || validatorPower <= reportedPower {
+		return power, nil
+	}
+
+	return math.NewInt(power).MulRaw(validatorPower).QuoRaw(reportedPower).Int64(), nil
+}
@@ -170,15 +170,22 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		}
 	}
 
-	for count := 0; iterator.Valid() && (count < int(maxValidators)) || (len(volunteerValidators) != 0); iterator.Next() {
+	volunteerParams, err := k.volunteerKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	volunteerSlots := min(len(volunteerValidators), int(volunteerParams.MaxVolunteerValidators))
+
+	var bondedValidators []bondedValidator
+	for count := 0; iterator.Valid() && (count < int(maxValidators) || volunteerSlots > 0); iterator.Next() {
 		// everything that is iterated in this loop is becoming or already a
 		// part of the bonded validator set
 		valAddr := sdk.ValAddress(iterator.Value())
 		validator := k.mustGetValidator(ctx, valAddr)
 
-		_, exist := volunteerValidators[validator.OperatorAddress]
-		if exist {
-			delete(volunteerValidators, validator.OperatorAddress)
+		_, isVolunteer := volunteerValidators[validator.OperatorAddress]
+		if isVolunteer && volunteerSlots > 0 {
+			volunteerSlots--
 		} else if count >= int(maxValidators) {
 			continue
 		}
@@ -213,26 +220,39 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 			panic("unexpected validator status")
 		}
 
+		bondedValidators = append(bondedValidators, bondedValidator{
+			valAddr:     valAddr,
+			validator:   validator,
+			power:       validator.ConsensusPower(powerReduction),
+			isVolunteer: isVolunteer,
+		})
+		count++
+	}
+
+	clampVolunteerPowers(bondedValidators, volunteerParams)
+
+	for _, bonded := range bondedValidators {
 		// fetch the old power bytes
-		valAddrStr, err := k.ValidatorAddressCodec().BytesToString(valAddr)
+		valAddrStr, err := k.ValidatorAddressCodec().BytesToString(bonded.valAddr)
 		if err != nil {
 			return nil, err
 		}
 		oldPowerBytes, found := last[valAddrStr]
-		newPower := validator.ConsensusPower(powerReduction)
+		newPower := bonded.power
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
 		// update the validator set if power has changed
 		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
-			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
+			update := bonded.validator.ABCIValidatorUpdate(powerReduction)
+			update.Power = newPower
+			updates = append(updates, update)
 
-			if err = k.SetLastValidatorPower(ctx, valAddr, newPower); err != nil {
+			if err = k.SetLastValidatorPower(ctx, bonded.valAddr, newPower); err != nil {
 				return nil, err
 			}
 		}
 
 		delete(last, valAddrStr)
-		count++
 
 		totalPower = totalPower.Add(math.NewInt(newPower))
 	}
@@ -30,11 +30,11 @@ func (k Keeper) GetLastValidators(ctx context.Context) (validators []types.Valid
 	}
 
 	// add to volunteer validator count
-	volunteerValidators, err := k.volunteerKeeper.GetVolunteerValidators(ctx)
+	volunteerSlots, err := k.volunteerValidatorSlots(ctx)
 	if err != nil {
 		return nil, err
 	}
-	maxValidators += uint32(len(volunteerValidators))
+	maxValidators += volunteerSlots
 
 	validators = make([]types.Validator, maxValidators)
 
@@ -63,3 +63,19 @@ func (k Keeper) GetLastValidators(ctx context.Context) (validators []types.Valid
 
 	return validators[:i], nil // trim
 }
+
+// volunteerValidatorSlots returns the number of the volunteer validators added
+// to the validator set on top of the max validators.
+func (k Keeper) volunteerValidatorSlots(ctx context.Context) (uint32, error) {
+	volunteerValidators, err := k.volunteerKeeper.GetVolunteerValidators(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	volunteerParams, err := k.volunteerKeeper.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return min(uint32(len(volunteerValidators)), volunteerParams.MaxVolunteerValidators), nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	volunteertypes "github.com/xpladev/xpla/x/volunteer/types"
+)
+
+// bondedValidator is a validator of the new validator set with the consensus
+// power reported to CometBFT.
+type bondedValidator struct {
+	valAddr     sdk.ValAddress
+	validator   types.Validator
+	power       int64
+	isVolunteer bool
+}
+
+// clampVolunteerPowers scales down the consensus powers of the volunteer
+// validators so that their share of the total power does not exceed the max
+// volunteer power fraction. A volunteer validator keeps at least 1 power to
+// stay in the validator set.
+func clampVolunteerPowers(bondedValidators []bondedValidator, params volunteertypes.Params) {
+	if params.MaxVolunteerPowerFraction.IsNil() || !params.MaxVolunteerPowerFraction.IsPositive() {
+		return
+	}
+
+	var volunteerPower, otherPower int64
+	for _, bonded := range bondedValidators {
+		if bonded.isVolunteer {
+			volunteerPower += bonded.power
+		} else {
+			otherPower += bonded.power
+		}
+	}
+
+	// without the other validators, the volunteer validators are the whole
+	// validator set and can not be scaled down
+	if otherPower == 0 {
+		return
+	}
+
+	maxVolunteerPower := params.MaxVolunteerPower(otherPower)
+	if math.NewInt(volunteerPower).LTE(maxVolunteerPower) {
+		return
+	}
+
+	for i := range bondedValidators {
+		if !bondedValidators[i].isVolunteer {
+			continue
+		}
+
+		power := math.NewInt(bondedValidators[i].power).Mul(maxVolunteerPower).QuoRaw(volunteerPower).Int64()
+		bondedValidators[i].power = max(power, 1)
+	}
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+
+	"github.com/stretchr/testify/require"
+
+	volunteertypes "github.com/xpladev/xpla/x/volunteer/types"
+)
+
+func TestClampVolunteerPowers(t *testing.T) {
+	params := volunteertypes.DefaultParams()
+
+	tests := []struct {
+		name      string
+		params    volunteertypes.Params
+		powers    []int64
+		volunteer []bool
+		expected  []int64
+	}{
+		{"nil fraction", volunteertypes.Params{}, []int64{100, 100}, []bool{false, true}, []int64{100, 100}},
+		{"only volunteer validators", params, []int64{100, 50}, []bool{true, true}, []int64{100, 50}},
+		{"within the max fraction", params, []int64{100, 25}, []bool{false, true}, []int64{100, 25}},
+		{"single volunteer validator", params, []int64{100, 100}, []bool{false, true}, []int64{100, 25}},
+		{"multiple volunteer validators", params, []int64{60, 100, 40}, []bool{true, false, true}, []int64{15, 100, 10}},
+		{"keep at least 1 power", params, []int64{100, 1000, 1}, []bool{false, true, true}, []int64{100, 24, 1}},
+		{"custom fraction", volunteertypes.Params{MaxVolunteerValidators: 1, MaxVolunteerPowerFraction: math.LegacyNewDecWithPrec(1, 1)}, []int64{90, 90}, []bool{false, true}, []int64{90, 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bondedValidators := make([]bondedValidator, len(tt.powers))
+			for i := range tt.powers {
+				bondedValidators[i] = bondedValidator{power: tt.powers[i], isVolunteer: tt.volunteer[i]}
+			}
+
+			clampVolunteerPowers(bondedValidators, tt.params)
+
+			powers := make([]int64, len(bondedValidators))
+			for i, bonded := range bondedValidators {
+				powers[i] = bonded.power
+			}
+			require.Equal(t, tt.expected, powers)
+		})
+	}
+}
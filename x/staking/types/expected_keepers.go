@@ -19,5 +19,7 @@ type BankKeeper interface {
 }
 
 type VolunteerKeeper interface {
+	GetVolunteerValidator(ctx context.Context, valAddress sdk.ValAddress) (volunteertypes.VolunteerValidator, error)
 	GetVolunteerValidators(ctx context.Context) (volunteerValidators map[string]volunteertypes.VolunteerValidator, err error)
+	GetParams(ctx context.Context) (params volunteertypes.Params, err error)
 }
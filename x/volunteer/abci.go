@@ -11,5 +11,10 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	if err != nil {
 		return err
 	}
-	return nil
+
+	return k.UpdateVolunteerValidatorPowers(ctx)
+}
+
+func EndBlocker(ctx context.Context, k keeper.Keeper) error {
+	return k.EndVolunteerValidatorTerms(ctx)
 }
@@ -5,7 +5,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/xpladev/xpla/x/volunteer/types"
 )
 
 type RejectDelegateVolunteerValidatorDecorator struct {
@@ -23,29 +26,49 @@ func (rdvvd RejectDelegateVolunteerValidatorDecorator) AnteHandle(ctx sdk.Contex
 		return next(ctx, tx, simulate)
 	}
 
-	for _, msg := range tx.GetMsgs() {
+	if err := rdvvd.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs checks the delegation messages including the ones nested in
+// authz.MsgExec. The staking hooks enforce the same restriction on the other
+// paths, and this check rejects the transaction early.
+func (rdvvd RejectDelegateVolunteerValidatorDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
 		switch msg := msg.(type) {
 		case *stakingtypes.MsgDelegate:
 			if err := rdvvd.checkVolunteerValidator(ctx, msg.ValidatorAddress, msg.DelegatorAddress); err != nil {
-				return ctx, err
+				return err
 			}
 		case *stakingtypes.MsgBeginRedelegate:
 			if err := rdvvd.checkVolunteerValidator(ctx, msg.ValidatorSrcAddress, msg.DelegatorAddress); err != nil {
-				return ctx, err
+				return err
 			}
 
 			if err := rdvvd.checkVolunteerValidator(ctx, msg.ValidatorDstAddress, msg.DelegatorAddress); err != nil {
-				return ctx, err
+				return err
 			}
 
 		case *stakingtypes.MsgUndelegate:
 			if err := rdvvd.checkVolunteerValidator(ctx, msg.ValidatorAddress, msg.DelegatorAddress); err != nil {
-				return ctx, err
+				return err
+			}
+		case *authz.MsgExec:
+			nestedMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := rdvvd.checkMsgs(ctx, nestedMsgs); err != nil {
+				return err
 			}
 		}
 	}
 
-	return next(ctx, tx, simulate)
+	return nil
 }
 
 func (rdvvd RejectDelegateVolunteerValidatorDecorator) checkVolunteerValidator(ctx sdk.Context, validatorAddress, delegatorAddress string) error {
@@ -64,7 +87,7 @@ func (rdvvd RejectDelegateVolunteerValidatorDecorator) checkVolunteerValidator(c
 			return nil
 		}
 
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot delegate to volunteer validator")
+		return errorsmod.Wrapf(types.ErrVolunteerValidatorDelegation, "validator: %s, delegator: %s", validatorAddress, delegatorAddress)
 	}
 
 	return nil
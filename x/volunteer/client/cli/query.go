@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 	"github.com/xpladev/xpla/x/volunteer/types"
@@ -22,6 +23,9 @@ func GetQueryCmd() *cobra.Command {
 
 	volunteerValidatorQueryCmd.AddCommand(
 		GetCmdQueryVolunteerValidators(),
+		GetCmdQueryVolunteerValidator(),
+		GetCmdQueryVolunteerValidatorTerm(),
+		GetCmdQueryParams(),
 	)
 
 	return volunteerValidatorQueryCmd
@@ -44,8 +48,78 @@ func GetCmdQueryVolunteerValidators() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			result, err := queryClient.VolunteerValidators(cmd.Context(), &types.QueryVolunteerValidatorsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validators")
+
+	return cmd
+}
+
+func GetCmdQueryVolunteerValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a volunteer validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details about a volunteer validator on a network.
+			
+			Example:
+			$ %s query volunteer validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj`, version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			result, err := queryClient.VolunteerValidator(cmd.Context(), &types.QueryVolunteerValidatorRequest{ValidatorAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryVolunteerValidatorTerm() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "term [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the remaining term of a volunteer validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the height at which a volunteer validator is unregistered and the number of remaining blocks.
+			
+			Example:
+			$ %s query volunteer term %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj`, version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
-			result, err := queryClient.VolunteerValidators(cmd.Context(), &types.QueryVolunteerValidatorsRequest{})
+			result, err := queryClient.VolunteerValidatorTerm(cmd.Context(), &types.QueryVolunteerValidatorTermRequest{ValidatorAddress: args[0]})
 			if err != nil {
 				return err
 			}
@@ -58,3 +132,29 @@ func GetCmdQueryVolunteerValidators() *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query volunteer params",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			result, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&result.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
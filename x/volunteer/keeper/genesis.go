@@ -1,11 +1,17 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/xpladev/xpla/x/volunteer/types"
 )
 
 func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
 	for _, volunteerValidator := range data.VolunteerValidators {
 		valAddress, err := sdk.ValAddressFromBech32(volunteerValidator.Address)
 		if err != nil {
@@ -14,6 +20,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 
 		k.SetVolunteerValidator(ctx, valAddress, *volunteerValidator)
 	}
+
+	for _, pendingRegistration := range data.PendingRegistrations {
+		valAddress, err := sdk.ValAddressFromBech32(pendingRegistration.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetPendingRegistration(ctx, valAddress, pendingRegistration.ProposalId); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
@@ -23,12 +40,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	state := []*types.VolunteerValidator{}
-	for valAddress, validator := range volunteerValidators {
-		state = append(state, &types.VolunteerValidator{
-			Address: valAddress,
-			Power:   validator.Power,
-		})
+	for _, validator := range volunteerValidators {
+		validator := validator
+		state = append(state, &validator)
+	}
+	sort.Slice(state, func(i, j int) bool { return state[i].Address < state[j].Address })
+
+	pendingRegistrations, err := k.GetPendingRegistrations(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
 	}
 
-	return types.NewGenesisState(state)
+	return types.NewGenesisState(state, pendingRegistrations, params)
 }
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/xpladev/xpla/x/volunteer/types"
+)
+
+var _ govtypes.GovHooks = VolunteerGovHooks{}
+
+// VolunteerGovHooks implements govtypes.GovHooks
+type VolunteerGovHooks struct {
+	keeper    Keeper
+	govKeeper types.GovKeeper
+}
+
+// NewGovHooksForVolunteer creates new gov hooks for volunteer keeper
+func NewGovHooksForVolunteer(k Keeper, gk types.GovKeeper) VolunteerGovHooks {
+	return VolunteerGovHooks{keeper: k, govKeeper: gk}
+}
+
+// AfterProposalSubmission implements govtypes.GovHooks
+// It keeps the volunteer validators registered by the proposal. Each proposal
+// has its own entry, so a later proposal for the same validator does not
+// overwrite it.
+func (h VolunteerGovHooks) AfterProposalSubmission(ctx context.Context, proposalID uint64) error {
+	res, err := h.govKeeper.Proposal(ctx, &govv1types.QueryProposalRequest{ProposalId: proposalID})
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range res.Proposal.Messages {
+		var sdkMsg sdk.Msg
+		if err := h.keeper.cdc.UnpackAny(msg, &sdkMsg); err != nil {
+			continue
+		}
+
+		registerMsg, ok := sdkMsg.(*types.MsgRegisterVolunteerValidator)
+		if !ok {
+			continue
+		}
+
+		valAddress, err := sdk.ValAddressFromBech32(registerMsg.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := h.keeper.SetPendingRegistration(ctx, valAddress, proposalID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterProposalDeposit implements govtypes.GovHooks
+func (h VolunteerGovHooks) AfterProposalDeposit(ctx context.Context, proposalID uint64, depositorAddr sdk.AccAddress) error {
+	return nil
+}
+
+// AfterProposalVote implements govtypes.GovHooks
+func (h VolunteerGovHooks) AfterProposalVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress) error {
+	return nil
+}
+
+// AfterProposalFailedMinDeposit implements govtypes.GovHooks
+func (h VolunteerGovHooks) AfterProposalFailedMinDeposit(ctx context.Context, proposalID uint64) error {
+	return h.keeper.DeletePendingRegistrationsOfProposal(ctx, proposalID)
+}
+
+// AfterProposalVotingPeriodEnded implements govtypes.GovHooks
+// The messages of a passed proposal are already executed when it is called, so
+// the volunteer validators registered in this block get the proposal id.
+func (h VolunteerGovHooks) AfterProposalVotingPeriodEnded(ctx context.Context, proposalID uint64) error {
+	res, err := h.govKeeper.Proposal(ctx, &govv1types.QueryProposalRequest{ProposalId: proposalID})
+	if err != nil {
+		return err
+	}
+
+	if res.Proposal.Status == govv1types.StatusPassed {
+		if err := h.setProposalID(ctx, proposalID); err != nil {
+			return err
+		}
+	}
+
+	return h.keeper.DeletePendingRegistrationsOfProposal(ctx, proposalID)
+}
+
+func (h VolunteerGovHooks) setProposalID(ctx context.Context, proposalID uint64) error {
+	pendingRegistrations, err := h.keeper.GetPendingRegistrations(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	for _, pendingRegistration := range pendingRegistrations {
+		if pendingRegistration.ProposalId != proposalID {
+			continue
+		}
+
+		valAddress, err := sdk.ValAddressFromBech32(pendingRegistration.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		volunteerValidator, err := h.keeper.GetVolunteerValidator(ctx, valAddress)
+		if err != nil || volunteerValidator.RegistrationHeight != height || volunteerValidator.ProposalId != 0 {
+			continue
+		}
+
+		volunteerValidator.ProposalId = proposalID
+		if err := h.keeper.SetVolunteerValidator(ctx, valAddress, volunteerValidator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/xpladev/xpla/x/volunteer/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,15 +25,82 @@ func (k Querier) VolunteerValidators(c context.Context, req *types.QueryVoluntee
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	addresses, err := k.GetVolunteerValidators(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.VolunteerValidatorKey)
+
+	volunteerValidators := []string{}
+	validators := []types.VolunteerValidator{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var validator types.VolunteerValidator
+		if err := k.cdc.Unmarshal(value, &validator); err != nil {
+			return err
+		}
+
+		volunteerValidators = append(volunteerValidators, validator.Address)
+		validators = append(validators, validator)
+		return nil
+	})
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	volunteerValidators := []string{}
-	for address, _ := range addresses {
-		volunteerValidators = append(volunteerValidators, address)
+	return &types.QueryVolunteerValidatorsResponse{
+		VolunteerValidators: volunteerValidators,
+		Validators:          validators,
+		Pagination:          pageRes,
+	}, nil
+}
+
+func (k Querier) VolunteerValidator(c context.Context, req *types.QueryVolunteerValidatorRequest) (*types.QueryVolunteerValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddress, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, err := k.GetVolunteerValidator(ctx, valAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "volunteer validator %s not found", req.ValidatorAddress)
+	}
+
+	return &types.QueryVolunteerValidatorResponse{Validator: validator}, nil
+}
+
+func (k Querier) VolunteerValidatorTerm(c context.Context, req *types.QueryVolunteerValidatorTermRequest) (*types.QueryVolunteerValidatorTermResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddress, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, err := k.GetVolunteerValidator(ctx, valAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "volunteer validator %s not found", req.ValidatorAddress)
+	}
+
+	return &types.QueryVolunteerValidatorTermResponse{
+		TermEndHeight:   validator.TermEndHeight,
+		RemainingBlocks: k.RemainingTermBlocks(ctx, validator),
+	}, nil
+}
+
+func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return &types.QueryVolunteerValidatorsResponse{VolunteerValidators: volunteerValidators}, nil
+	return &types.QueryParamsResponse{Params: params}, nil
 }
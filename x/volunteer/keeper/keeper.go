@@ -18,16 +18,22 @@ type Keeper struct {
 
 	stakingKeeper types.StakingKeeper
 	distKeeper    types.DistributionKeeper
+	bankKeeper    types.BankKeeper
+	rewardKeeper  types.RewardKeeper
+	burnKeeper    types.BurnKeeper
 	authority     string
 }
 
 // NewKeeper constructs a message authorization Keeper
-func NewKeeper(storeService store.KVStoreService, cdc codec.BinaryCodec, sk types.StakingKeeper, dk types.DistributionKeeper, authority string) Keeper {
+func NewKeeper(storeService store.KVStoreService, cdc codec.BinaryCodec, sk types.StakingKeeper, dk types.DistributionKeeper, bk types.BankKeeper, rk types.RewardKeeper, burnk types.BurnKeeper, authority string) Keeper {
 	return Keeper{
 		storeService:  storeService,
 		cdc:           cdc,
 		stakingKeeper: sk,
 		distKeeper:    dk,
+		bankKeeper:    bk,
+		rewardKeeper:  rk,
+		burnKeeper:    burnk,
 		authority:     authority,
 	}
 }
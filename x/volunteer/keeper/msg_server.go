@@ -5,6 +5,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/xpladev/xpla/x/volunteer/types"
@@ -35,7 +36,34 @@ func (k msgServer) RegisterVolunteerValidator(goCtx context.Context, req *types.
 		return nil, stakingtypes.ErrValidatorOwnerExists
 	}
 
-	k.SetVolunteerValidator(ctx, valAddress, types.NewVolunteerValidator(valAddress, 0))
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	volunteerValidators, err := k.GetVolunteerValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if uint32(len(volunteerValidators)) >= params.MaxVolunteerValidators {
+		return nil, errorsmod.Wrapf(types.ErrMaxVolunteerValidators, "max: %d", params.MaxVolunteerValidators)
+	}
+
+	if err := k.validateCommissionDestination(req.CommissionDestination); err != nil {
+		return nil, err
+	}
+
+	volunteerValidator := types.NewVolunteerValidator(valAddress, 0)
+	volunteerValidator.RegistrationHeight = ctx.BlockHeight()
+	volunteerValidator.RegistrationTime = ctx.BlockTime()
+	volunteerValidator.OperatorContact = req.OperatorContact
+	volunteerValidator.OperatorDescription = req.OperatorDescription
+	volunteerValidator.CommissionDestination = req.CommissionDestination
+	volunteerValidator.TermEndHeight = params.TermEndHeight(ctx.BlockHeight())
+	volunteerValidator.UpdatePower(ctx.BlockHeight(), 0)
+
+	k.SetVolunteerValidator(ctx, valAddress, volunteerValidator)
 
 	createValidatorMsg := req.ToCreateValidator()
 	if err := k.CreateValidator(ctx, createValidatorMsg); err != nil {
@@ -56,19 +84,73 @@ func (k msgServer) UnregisterVolunteerValidator(goCtx context.Context, req *type
 		return nil, err
 	}
 
-	_, err = k.GetVolunteerValidator(ctx, valAddress)
+	if err := k.Keeper.UnregisterVolunteerValidator(ctx, valAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnregisterVolunteerValidatorResponse{}, nil
+}
+
+func (k msgServer) UpdateCommissionDestination(goCtx context.Context, req *types.MsgUpdateCommissionDestination) (*types.MsgUpdateCommissionDestinationResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddress, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.validateCommissionDestination(req.CommissionDestination); err != nil {
+		return nil, err
+	}
+
+	volunteerValidator, err := k.GetVolunteerValidator(ctx, valAddress)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, `volunteer validator (%s)`, valAddress.String())
 	}
 
-	if validator, err := k.stakingKeeper.GetValidator(ctx, valAddress); err == nil {
-		_, _, err := k.stakingKeeper.Undelegate(ctx, sdk.AccAddress(valAddress), valAddress, validator.DelegatorShares)
-		if err != nil {
-			return nil, err
-		}
+	volunteerValidator.CommissionDestination = req.CommissionDestination
+	if err := k.SetVolunteerValidator(ctx, valAddress, volunteerValidator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateCommissionDestinationResponse{}, nil
+}
 
-		k.DeleteVolunteerValidator(ctx, valAddress)
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
 	}
 
-	return &types.MsgUnregisterVolunteerValidatorResponse{}, nil
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// validateCommissionDestination checks the commission destination and that the
+// address of an account destination is allowed to receive funds.
+func (k msgServer) validateCommissionDestination(destination types.CommissionDestination) error {
+	if err := destination.Validate(); err != nil {
+		return err
+	}
+
+	if destination.Type != types.CommissionDestinationAccount {
+		return nil
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(destination.Address)
+	if err != nil {
+		return err
+	}
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive commission", destination.Address)
+	}
+
+	return nil
 }
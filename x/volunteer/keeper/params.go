@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/xpladev/xpla/x/volunteer/types"
+)
+
+// GetParams returns the volunteer parameters, or the default parameters if
+// they are not set.
+func (k Keeper) GetParams(ctx context.Context) (params types.Params, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ParamsKey)
+	if err != nil {
+		return params, err
+	}
+
+	if bz == nil {
+		return types.DefaultParams(), nil
+	}
+
+	err = k.cdc.Unmarshal(bz, &params)
+	return params, err
+}
+
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.ParamsKey, bz)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/xpladev/xpla/x/volunteer/types"
+)
+
+// EndVolunteerValidatorTerms unregisters the volunteer validators whose term
+// ends at or before the current block height. If the unregistration fails, the
+// volunteer validator record is kept, so that it is retried in the next block.
+func (k Keeper) EndVolunteerValidatorTerms(ctx context.Context) error {
+	volunteerValidators, err := k.GetVolunteerValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	strValAddrs := make([]string, 0, len(volunteerValidators))
+	for strValAddr := range volunteerValidators {
+		strValAddrs = append(strValAddrs, strValAddr)
+	}
+	sort.Strings(strValAddrs)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, strValAddr := range strValAddrs {
+		volunteerValidator := volunteerValidators[strValAddr]
+		if volunteerValidator.TermEndHeight == 0 || volunteerValidator.TermEndHeight > sdkCtx.BlockHeight() {
+			continue
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(strValAddr)
+		if err != nil {
+			return err
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.UnregisterVolunteerValidator(cacheCtx, valAddr); err != nil {
+			k.Logger(ctx).Error("failed to unregister volunteer validator at the end of its term",
+				"validator", strValAddr, "term_end_height", volunteerValidator.TermEndHeight, "err", err)
+			continue
+		}
+		write()
+
+		if err := k.DeleteVolunteerValidator(ctx, valAddr); err != nil {
+			return err
+		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventVolunteerValidatorTermEnded{
+			ValidatorAddress: strValAddr,
+			TermEndHeight:    volunteerValidator.TermEndHeight,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemainingTermBlocks returns the number of blocks until the end of the term
+// of the volunteer validator, or zero if it has no term limit.
+func (k Keeper) RemainingTermBlocks(ctx context.Context, volunteerValidator types.VolunteerValidator) int64 {
+	if volunteerValidator.TermEndHeight == 0 {
+		return 0
+	}
+
+	remaining := volunteerValidator.TermEndHeight - sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if remaining < 0 {
+		return 0
+	}
+
+	return remaining
+}
@@ -2,8 +2,12 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	burntypes "github.com/xpladev/xpla/x/burn/types"
+	"github.com/xpladev/xpla/x/volunteer/types"
 )
 
 func (k Keeper) VolunteerValidatorCommissionProcess(ctx context.Context) error {
@@ -12,7 +16,13 @@ func (k Keeper) VolunteerValidatorCommissionProcess(ctx context.Context) error {
 		return err
 	}
 
-	for strValAddr, _ := range volunteerValidators {
+	strValAddrs := make([]string, 0, len(volunteerValidators))
+	for strValAddr := range volunteerValidators {
+		strValAddrs = append(strValAddrs, strValAddr)
+	}
+	sort.Strings(strValAddrs)
+
+	for _, strValAddr := range strValAddrs {
 		valAddr, err := sdk.ValAddressFromBech32(strValAddr)
 		if err != nil {
 			return err
@@ -23,11 +33,67 @@ func (k Keeper) VolunteerValidatorCommissionProcess(ctx context.Context) error {
 			continue
 		}
 
-		err = k.distKeeper.FundCommunityPool(ctx, commissions, sdk.AccAddress(valAddr.Bytes()))
-		if err != nil {
-			return err
+		sender := sdk.AccAddress(valAddr.Bytes())
+		destination := volunteerValidators[strValAddr].CommissionDestination
+		if err := k.trySendCommission(ctx, sender, commissions, destination); err != nil {
+			k.Logger(ctx).Error("failed to send volunteer validator commission, sending to the community pool",
+				"validator", strValAddr, "destination", destination.Type.String(), "amount", commissions.String(), "err", err)
+
+			if err := k.distKeeper.FundCommunityPool(ctx, commissions, sender); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
+
+// trySendCommission sends the commission to the destination in a cache context
+// so that a failed transfer does not leave a partial state change.
+func (k Keeper) trySendCommission(ctx context.Context, sender sdk.AccAddress, amount sdk.Coins, destination types.CommissionDestination) error {
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if err := k.sendCommission(cacheCtx, sender, amount, destination); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+func (k Keeper) sendCommission(ctx context.Context, sender sdk.AccAddress, amount sdk.Coins, destination types.CommissionDestination) error {
+	switch destination.Type {
+	case types.CommissionDestinationRewardPool:
+		params, err := k.rewardKeeper.GetParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		fundable, others := params.SplitFundableCoins(amount)
+		if !fundable.IsZero() {
+			if err := k.rewardKeeper.FundRewardPool(ctx, fundable, sender); err != nil {
+				return err
+			}
+		}
+
+		if !others.IsZero() {
+			return k.distKeeper.FundCommunityPool(ctx, others, sender)
+		}
+
+		return nil
+	case types.CommissionDestinationBurn:
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, burntypes.ModuleName, amount); err != nil {
+			return err
+		}
+
+		return k.burnKeeper.BurnCoins(ctx, amount)
+	case types.CommissionDestinationAccount:
+		recipient, err := sdk.AccAddressFromBech32(destination.Address)
+		if err != nil {
+			return err
+		}
+
+		return k.bankKeeper.SendCoins(ctx, sender, recipient, amount)
+	default:
+		return k.distKeeper.FundCommunityPool(ctx, amount, sender)
+	}
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -37,6 +39,26 @@ func (k Keeper) DeleteVolunteerValidator(ctx context.Context, valAddress sdk.Val
 	return store.Delete(types.GetVolunteerValidatorKey(valAddress))
 }
 
+// UnregisterVolunteerValidator undelegates all the delegations of the volunteer
+// validator and removes it from the volunteer validators.
+func (k Keeper) UnregisterVolunteerValidator(ctx context.Context, valAddress sdk.ValAddress) error {
+	_, err := k.GetVolunteerValidator(ctx, valAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, `volunteer validator (%s)`, valAddress.String())
+	}
+
+	if validator, err := k.stakingKeeper.GetValidator(ctx, valAddress); err == nil {
+		_, _, err := k.stakingKeeper.Undelegate(ctx, sdk.AccAddress(valAddress), valAddress, validator.DelegatorShares)
+		if err != nil {
+			return err
+		}
+
+		return k.DeleteVolunteerValidator(ctx, valAddress)
+	}
+
+	return nil
+}
+
 func (k Keeper) GetVolunteerValidators(ctx context.Context) (volunteerValidators map[string]types.VolunteerValidator, err error) {
 	volunteerValidators = make(map[string]types.VolunteerValidator)
 	store := k.storeService.OpenKVStore(ctx)
@@ -56,3 +78,108 @@ func (k Keeper) GetVolunteerValidators(ctx context.Context) (volunteerValidators
 
 	return volunteerValidators, nil
 }
+
+// UpdateVolunteerValidatorPowers records the consensus power changes of the
+// volunteer validators in their power history.
+func (k Keeper) UpdateVolunteerValidatorPowers(ctx context.Context) error {
+	volunteerValidators, err := k.GetVolunteerValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	strValAddrs := make([]string, 0, len(volunteerValidators))
+	for strValAddr := range volunteerValidators {
+		strValAddrs = append(strValAddrs, strValAddr)
+	}
+	sort.Strings(strValAddrs)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+	for _, strValAddr := range strValAddrs {
+		valAddr, err := sdk.ValAddressFromBech32(strValAddr)
+		if err != nil {
+			return err
+		}
+
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			continue
+		}
+
+		volunteerValidator := volunteerValidators[strValAddr]
+		if !volunteerValidator.UpdatePower(sdkCtx.BlockHeight(), validator.ConsensusPower(powerReduction)) {
+			continue
+		}
+
+		if err := k.SetVolunteerValidator(ctx, valAddr, volunteerValidator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HasPendingRegistration returns whether the ongoing governance proposal
+// registers the volunteer validator.
+func (k Keeper) HasPendingRegistration(ctx context.Context, valAddress sdk.ValAddress, proposalID uint64) (bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Has(types.GetPendingRegistrationKey(valAddress, proposalID))
+}
+
+func (k Keeper) SetPendingRegistration(ctx context.Context, valAddress sdk.ValAddress, proposalID uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetPendingRegistrationKey(valAddress, proposalID), []byte{})
+}
+
+func (k Keeper) DeletePendingRegistration(ctx context.Context, valAddress sdk.ValAddress, proposalID uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetPendingRegistrationKey(valAddress, proposalID))
+}
+
+// DeletePendingRegistrationsOfProposal removes the pending registrations of a
+// governance proposal.
+func (k Keeper) DeletePendingRegistrationsOfProposal(ctx context.Context, proposalID uint64) error {
+	pendingRegistrations, err := k.GetPendingRegistrations(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pendingRegistration := range pendingRegistrations {
+		if pendingRegistration.ProposalId != proposalID {
+			continue
+		}
+
+		valAddress, err := sdk.ValAddressFromBech32(pendingRegistration.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.DeletePendingRegistration(ctx, valAddress, proposalID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) GetPendingRegistrations(ctx context.Context) ([]types.PendingRegistration, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.PendingRegistrationKey, storetypes.PrefixEndBytes(types.PendingRegistrationKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	pendingRegistrations := []types.PendingRegistration{}
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the prefix, the length prefixed address and the proposal id
+		key := iterator.Key()[len(types.PendingRegistrationKey):]
+		valAddress := sdk.ValAddress(key[1 : 1+key[0]])
+		pendingRegistrations = append(pendingRegistrations, types.PendingRegistration{
+			ValidatorAddress: valAddress.String(),
+			ProposalId:       sdk.BigEndianToUint64(key[1+key[0]:]),
+		})
+	}
+
+	return pendingRegistrations, nil
+}
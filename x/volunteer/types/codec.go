@@ -11,6 +11,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterVolunteerValidator{}, "xpladev/MsgRegisterVolunteerValidator")
 	legacy.RegisterAminoMsg(cdc, &MsgUnregisterVolunteerValidator{}, "xpladev/MsgUnregisterVolunteerValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateCommissionDestination{}, "xpladev/MsgUpdateCommissionDestination")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "xpladev/x/volunteer/MsgUpdateParams")
 
 	cdc.RegisterConcrete(&RegisterVolunteerValidatorProposal{}, "xpladev/RegisterVolunteerValidatorProposal", nil)
 	cdc.RegisterConcrete(&RegisterVolunteerValidatorProposalWithDeposit{}, "xpladev/RegisterVolunteerValidatorProposalWithDeposit", nil)
@@ -29,5 +31,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgRegisterVolunteerValidator{},
 		&MsgUnregisterVolunteerValidator{},
+		&MsgUpdateCommissionDestination{},
+		&MsgUpdateParams{},
 	)
 }
@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/volunteer module sentinel errors
+var (
+	ErrMaxVolunteerValidators       = errorsmod.Register(ModuleName, 2, "max volunteer validators reached")
+	ErrVolunteerValidatorDelegation = errorsmod.Register(ModuleName, 3, "cannot delegate to volunteer validator")
+)
@@ -8,7 +8,10 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	rewardtypes "github.com/xpladev/xpla/x/reward/types"
 )
 
 type StakingKeeper interface {
@@ -27,9 +30,33 @@ type StakingKeeper interface {
 	) (time.Time, sdkmath.Int, error)
 	Hooks() stakingtypes.StakingHooks
 	ValidatorAddressCodec() address.Codec
+	PowerReduction(ctx context.Context) sdkmath.Int
 }
 
 type DistributionKeeper interface {
 	WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error)
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// BankKeeper defines the expected bank keeper interface
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// RewardKeeper defines the expected reward keeper interface
+type RewardKeeper interface {
+	GetParams(ctx context.Context) (rewardtypes.Params, error)
+	FundRewardPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// BurnKeeper defines the expected burn keeper interface
+type BurnKeeper interface {
+	BurnCoins(ctx context.Context, amount sdk.Coins) error
+}
+
+// GovKeeper defines the expected governance keeper interface
+type GovKeeper interface {
+	Proposal(ctx context.Context, req *govv1types.QueryProposalRequest) (*govv1types.QueryProposalResponse, error)
+}
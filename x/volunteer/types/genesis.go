@@ -1,26 +1,50 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func NewGenesisState(volunteerValidators []*VolunteerValidator) *GenesisState {
+func NewGenesisState(volunteerValidators []*VolunteerValidator, pendingRegistrations []PendingRegistration, params Params) *GenesisState {
 	return &GenesisState{
-		VolunteerValidators: volunteerValidators,
+		VolunteerValidators:  volunteerValidators,
+		PendingRegistrations: pendingRegistrations,
+		Params:               params,
 	}
 }
 
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		VolunteerValidators: []*VolunteerValidator{},
+		VolunteerValidators:  []*VolunteerValidator{},
+		PendingRegistrations: []PendingRegistration{},
+		Params:               DefaultParams(),
 	}
 }
 
 func ValidateGenesis(gs *GenesisState) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, addr := range gs.VolunteerValidators {
 		if _, err := sdk.ValAddressFromBech32(addr.Address); err != nil {
 			return err
 		}
+
+		if len(addr.PowerHistory) > MaxPowerHistoryLength {
+			return fmt.Errorf("power history of %s exceeds the max length: %d", addr.Address, len(addr.PowerHistory))
+		}
+
+		if err := addr.CommissionDestination.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, pendingRegistration := range gs.PendingRegistrations {
+		if _, err := sdk.ValAddressFromBech32(pendingRegistration.ValidatorAddress); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -25,6 +26,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the volunteer module's genesis state.
 type GenesisState struct {
 	VolunteerValidators []*VolunteerValidator `protobuf:"bytes,1,rep,name=volunteer_validators,json=volunteerValidators,proto3" json:"volunteer_validators,omitempty"`
+	// pending_registrations defines the volunteer validators waiting to be
+	// registered by the ongoing governance proposals.
+	PendingRegistrations []PendingRegistration `protobuf:"bytes,2,rep,name=pending_registrations,json=pendingRegistrations,proto3" json:"pending_registrations"`
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -67,8 +73,79 @@ func (m *GenesisState) GetVolunteerValidators() []*VolunteerValidator {
 	return nil
 }
 
+func (m *GenesisState) GetPendingRegistrations() []PendingRegistration {
+	if m != nil {
+		return m.PendingRegistrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// PendingRegistration defines a volunteer validator to be registered by an
+// ongoing governance proposal.
+type PendingRegistration struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// proposal_id is the id of the governance proposal.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *PendingRegistration) Reset()         { *m = PendingRegistration{} }
+func (m *PendingRegistration) String() string { return proto.CompactTextString(m) }
+func (*PendingRegistration) ProtoMessage()    {}
+func (*PendingRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17dc7ccba2dd7aa7, []int{1}
+}
+func (m *PendingRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRegistration.Merge(m, src)
+}
+func (m *PendingRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRegistration proto.InternalMessageInfo
+
+func (m *PendingRegistration) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *PendingRegistration) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "xpla.volunteer.v1beta1.GenesisState")
+	proto.RegisterType((*PendingRegistration)(nil), "xpla.volunteer.v1beta1.PendingRegistration")
 }
 
 func init() {
@@ -76,20 +153,29 @@ func init() {
 }
 
 var fileDescriptor_17dc7ccba2dd7aa7 = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xa9, 0x28, 0xc8, 0x49,
-	0xd4, 0x2f, 0xcb, 0xcf, 0x29, 0xcd, 0x2b, 0x49, 0x4d, 0x2d, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x03, 0xa9, 0xd2, 0x83, 0xab, 0xd2, 0x83, 0xaa, 0x92, 0xd2, 0xc7, 0xa1, 0x1b, 0x2e,
-	0x52, 0x96, 0x98, 0x93, 0x99, 0x92, 0x58, 0x92, 0x5f, 0x04, 0x31, 0x48, 0x29, 0x97, 0x8b, 0xc7,
-	0x1d, 0x62, 0x72, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x50, 0x2c, 0x97, 0x08, 0x5c, 0x6d, 0x3c, 0x5c,
-	0x71, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x96, 0x1e, 0x76, 0x7b, 0xf5, 0xc2, 0x60,
-	0x22, 0x61, 0x30, 0x2d, 0x41, 0xc2, 0x65, 0x18, 0x62, 0xc5, 0x4e, 0xce, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x99, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0x0b, 0xf6, 0x44, 0x4a, 0x6a, 0x19, 0xc4, 0x33, 0x15, 0x48, 0xde, 0x29, 0xa9, 0x2c, 0x48,
-	0x2d, 0x4e, 0x62, 0x03, 0x3b, 0xdd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x58, 0x54, 0x1b,
-	0x2b, 0x01, 0x00, 0x00,
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x6b, 0xc2, 0x30,
+	0x1c, 0xc5, 0x1b, 0x15, 0x61, 0x71, 0x87, 0xad, 0xba, 0x51, 0x3c, 0x44, 0x91, 0x1d, 0xba, 0x09,
+	0x2d, 0xba, 0xeb, 0x2e, 0x73, 0x87, 0xb1, 0xdb, 0xe8, 0xc0, 0xc3, 0x60, 0x94, 0x68, 0xb2, 0x2e,
+	0x50, 0x9b, 0x90, 0xc4, 0xe2, 0xbe, 0xc5, 0x60, 0x5f, 0xca, 0xa3, 0xc7, 0x9d, 0xc6, 0xd0, 0x2f,
+	0x32, 0x6c, 0x34, 0x08, 0xea, 0x2d, 0xbc, 0xff, 0xef, 0xbd, 0x7f, 0xf8, 0x3f, 0x78, 0x35, 0x13,
+	0x29, 0x0e, 0x73, 0x9e, 0x4e, 0x33, 0x4d, 0xa9, 0x0c, 0xf3, 0xde, 0x88, 0x6a, 0xdc, 0x0b, 0x13,
+	0x9a, 0x51, 0xc5, 0x54, 0x20, 0x24, 0xd7, 0xdc, 0xbd, 0x5c, 0x53, 0x81, 0xa5, 0x82, 0x0d, 0xd5,
+	0x6c, 0x24, 0x3c, 0xe1, 0x05, 0x12, 0xae, 0x5f, 0x86, 0x6e, 0x86, 0x47, 0x32, 0xad, 0x92, 0xe3,
+	0x94, 0x11, 0xac, 0xb9, 0x34, 0x86, 0xce, 0x77, 0x09, 0x9e, 0x3e, 0x9a, 0x85, 0x2f, 0x1a, 0x6b,
+	0xea, 0xbe, 0xc1, 0x86, 0x85, 0x63, 0x4b, 0x2b, 0x0f, 0xb4, 0xcb, 0x7e, 0xad, 0x7f, 0x13, 0x1c,
+	0xfe, 0x4e, 0x30, 0xdc, 0x2a, 0xc3, 0xad, 0x25, 0xaa, 0xe7, 0x7b, 0x9a, 0x72, 0xdf, 0xe1, 0x85,
+	0xa0, 0x19, 0x61, 0x59, 0x12, 0x4b, 0x9a, 0x30, 0xa5, 0x25, 0xd6, 0x8c, 0x67, 0xca, 0x2b, 0x15,
+	0xf9, 0xdd, 0x63, 0xf9, 0xcf, 0xc6, 0x14, 0xed, 0x78, 0x06, 0x95, 0xf9, 0x6f, 0xcb, 0x89, 0x1a,
+	0x62, 0x7f, 0xa4, 0xdc, 0x3b, 0x58, 0x15, 0x58, 0xe2, 0x89, 0xf2, 0xca, 0x6d, 0xe0, 0xd7, 0xfa,
+	0xe8, 0x68, 0x70, 0x41, 0x6d, 0xb2, 0x36, 0x9e, 0xce, 0x18, 0xd6, 0x0f, 0x2c, 0x74, 0xbb, 0xf0,
+	0xdc, 0x5e, 0x24, 0xc6, 0x84, 0x48, 0xaa, 0xd6, 0x87, 0x01, 0xfe, 0x49, 0x74, 0x66, 0x07, 0xf7,
+	0x46, 0x77, 0x5b, 0xb0, 0x26, 0x24, 0x17, 0x5c, 0xe1, 0x34, 0x66, 0xc4, 0x2b, 0xb5, 0x81, 0x5f,
+	0x89, 0xe0, 0x56, 0x7a, 0x22, 0x83, 0x87, 0xf9, 0x12, 0x81, 0xc5, 0x12, 0x81, 0xbf, 0x25, 0x02,
+	0x5f, 0x2b, 0xe4, 0x2c, 0x56, 0xc8, 0xf9, 0x59, 0x21, 0xe7, 0xf5, 0x3a, 0x61, 0xfa, 0x63, 0x3a,
+	0x0a, 0xc6, 0x7c, 0x52, 0x14, 0x4a, 0x68, 0x6e, 0x8a, 0x9d, 0xed, 0x54, 0xab, 0x3f, 0x05, 0x55,
+	0xa3, 0x6a, 0x51, 0xe3, 0xed, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xf9, 0x4e, 0xe4, 0x4d,
+	0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +198,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PendingRegistrations) > 0 {
+		for iNdEx := len(m.PendingRegistrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRegistrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.VolunteerValidators) > 0 {
 		for iNdEx := len(m.VolunteerValidators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -129,6 +239,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -152,6 +297,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingRegistrations) > 0 {
+		for _, e := range m.PendingRegistrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PendingRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.ProposalId))
+	}
 	return n
 }
 
@@ -224,6 +393,174 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRegistrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRegistrations = append(m.PendingRegistrations, PendingRegistration{})
+			if err := m.PendingRegistrations[len(m.PendingRegistrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -18,9 +18,19 @@ const (
 
 var (
 	// Keys for store prefixes
-	VolunteerValidatorKey = []byte{0x11}
+	VolunteerValidatorKey  = []byte{0x11}
+	PendingRegistrationKey = []byte{0x12}
+	ParamsKey              = []byte{0x13}
 )
 
 func GetVolunteerValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(VolunteerValidatorKey, address.MustLengthPrefix(operatorAddr)...)
 }
+
+func GetPendingRegistrationsKey(operatorAddr sdk.ValAddress) []byte {
+	return append(PendingRegistrationKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+func GetPendingRegistrationKey(operatorAddr sdk.ValAddress, proposalID uint64) []byte {
+	return append(GetPendingRegistrationsKey(operatorAddr), sdk.Uint64ToBigEndian(proposalID)...)
+}
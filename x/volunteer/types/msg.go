@@ -14,12 +14,16 @@ import (
 const (
 	TypeMsgRegisterVolunteerValidator   = "register_volunteer_validator"
 	TypeMsgUnregisterVolunteerValidator = "unregister_volunteer_validator"
+	TypeMsgUpdateCommissionDestination  = "update_commission_destination"
+	TypeMsgUpdateParams                 = "update_params"
 )
 
 var (
 	_ sdk.Msg                            = (*MsgRegisterVolunteerValidator)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgRegisterVolunteerValidator)(nil)
 	_ sdk.Msg                            = (*MsgUnregisterVolunteerValidator)(nil)
+	_ sdk.Msg                            = (*MsgUpdateCommissionDestination)(nil)
+	_ sdk.Msg                            = (*MsgUpdateParams)(nil)
 )
 
 func NewMsgRegisterVolunteerValidator(title, description string, delAddr sdk.AccAddress, valAddr sdk.ValAddress, pubKey cryptotypes.PubKey,
@@ -80,6 +84,18 @@ func (msg MsgRegisterVolunteerValidator) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty description")
 	}
 
+	if len(msg.OperatorContact) > stakingtypes.MaxSecurityContactLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid operator contact length; got: %d, max: %d", len(msg.OperatorContact), stakingtypes.MaxSecurityContactLength)
+	}
+
+	if len(msg.OperatorDescription) > stakingtypes.MaxDetailsLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid operator description length; got: %d, max: %d", len(msg.OperatorDescription), stakingtypes.MaxDetailsLength)
+	}
+
+	if err := msg.CommissionDestination.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -117,3 +133,27 @@ func (msg MsgUnregisterVolunteerValidator) ValidateBasic() error {
 
 	return nil
 }
+
+func (msg MsgUpdateCommissionDestination) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if msg.ValidatorAddress == "" {
+		return stakingtypes.ErrEmptyValidatorAddr
+	}
+
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return err
+	}
+
+	return msg.CommissionDestination.Validate()
+}
+
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
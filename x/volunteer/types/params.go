@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	DefaultMaxVolunteerValidators uint32 = 10
+)
+
+var (
+	DefaultMaxVolunteerPowerFraction = sdkmath.LegacyNewDecWithPrec(2, 1)
+
+	// MaxVolunteerPowerFractionLimit is the upper bound of
+	// MaxVolunteerPowerFraction, so that the volunteer validators can never
+	// hold enough consensus power to halt the chain.
+	MaxVolunteerPowerFractionLimit = sdkmath.LegacyOneDec().QuoInt64(3)
+)
+
+// DefaultParams returns default volunteer parameters
+func DefaultParams() Params {
+	return Params{
+		MaxTermBlocks:             0,
+		MaxVolunteerValidators:    DefaultMaxVolunteerValidators,
+		MaxVolunteerPowerFraction: DefaultMaxVolunteerPowerFraction,
+	}
+}
+
+// Validate performs basic validation on volunteer parameters.
+func (p Params) Validate() error {
+	if p.MaxVolunteerValidators == 0 {
+		return fmt.Errorf("max volunteer validators must be positive")
+	}
+
+	if p.MaxVolunteerPowerFraction.IsNil() || !p.MaxVolunteerPowerFraction.IsPositive() {
+		return fmt.Errorf("max volunteer power fraction must be positive: %s", p.MaxVolunteerPowerFraction)
+	}
+
+	if p.MaxVolunteerPowerFraction.GT(MaxVolunteerPowerFractionLimit) {
+		return fmt.Errorf("max volunteer power fraction must not exceed %s: %s", MaxVolunteerPowerFractionLimit, p.MaxVolunteerPowerFraction)
+	}
+
+	return nil
+}
+
+// TermEndHeight returns the height at which the term of a volunteer validator
+// registered at the height ends, or zero if there is no term limit.
+func (p Params) TermEndHeight(registrationHeight int64) int64 {
+	if p.MaxTermBlocks == 0 {
+		return 0
+	}
+
+	return registrationHeight + int64(p.MaxTermBlocks)
+}
+
+// MaxVolunteerPower returns the maximum total consensus power of the volunteer
+// validators against the total consensus power of the other validators.
+func (p Params) MaxVolunteerPower(otherPower int64) sdkmath.Int {
+	fraction := p.MaxVolunteerPowerFraction
+	return fraction.MulInt64(otherPower).Quo(sdkmath.LegacyOneDec().Sub(fraction)).TruncateInt()
+}
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -30,6 +33,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // QueryVolunteerValidatorsRequest
 type QueryVolunteerValidatorsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryVolunteerValidatorsRequest) Reset()         { *m = QueryVolunteerValidatorsRequest{} }
@@ -65,9 +70,20 @@ func (m *QueryVolunteerValidatorsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryVolunteerValidatorsRequest proto.InternalMessageInfo
 
+func (m *QueryVolunteerValidatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryVolunteerValidatorsResponse
 type QueryVolunteerValidatorsResponse struct {
 	VolunteerValidators []string `protobuf:"bytes,1,rep,name=volunteer_validators,json=volunteerValidators,proto3" json:"volunteer_validators,omitempty"`
+	// validators defines the volunteer validator records.
+	Validators []VolunteerValidator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryVolunteerValidatorsResponse) Reset()         { *m = QueryVolunteerValidatorsResponse{} }
@@ -110,9 +126,310 @@ func (m *QueryVolunteerValidatorsResponse) GetVolunteerValidators() []string {
 	return nil
 }
 
+func (m *QueryVolunteerValidatorsResponse) GetValidators() []VolunteerValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *QueryVolunteerValidatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVolunteerValidatorRequest is the request type for the
+// Query/VolunteerValidator RPC method.
+type QueryVolunteerValidatorRequest struct {
+	// validator_address defines the address of the volunteer validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryVolunteerValidatorRequest) Reset()         { *m = QueryVolunteerValidatorRequest{} }
+func (m *QueryVolunteerValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVolunteerValidatorRequest) ProtoMessage()    {}
+func (*QueryVolunteerValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66f35a978c82749b, []int{2}
+}
+func (m *QueryVolunteerValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVolunteerValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVolunteerValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVolunteerValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVolunteerValidatorRequest.Merge(m, src)
+}
+func (m *QueryVolunteerValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVolunteerValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVolunteerValidatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVolunteerValidatorRequest proto.InternalMessageInfo
+
+func (m *QueryVolunteerValidatorRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryVolunteerValidatorResponse is the response type for the
+// Query/VolunteerValidator RPC method.
+type QueryVolunteerValidatorResponse struct {
+	// validator defines the volunteer validator record.
+	Validator VolunteerValidator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator"`
+}
+
+func (m *QueryVolunteerValidatorResponse) Reset()         { *m = QueryVolunteerValidatorResponse{} }
+func (m *QueryVolunteerValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVolunteerValidatorResponse) ProtoMessage()    {}
+func (*QueryVolunteerValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66f35a978c82749b, []int{3}
+}
+func (m *QueryVolunteerValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVolunteerValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVolunteerValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVolunteerValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVolunteerValidatorResponse.Merge(m, src)
+}
+func (m *QueryVolunteerValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVolunteerValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVolunteerValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVolunteerValidatorResponse proto.InternalMessageInfo
+
+func (m *QueryVolunteerValidatorResponse) GetValidator() VolunteerValidator {
+	if m != nil {
+		return m.Validator
+	}
+	return VolunteerValidator{}
+}
+
+// QueryVolunteerValidatorTermRequest is the request type for the
+// Query/VolunteerValidatorTerm RPC method.
+type QueryVolunteerValidatorTermRequest struct {
+	// validator_address defines the address of the volunteer validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryVolunteerValidatorTermRequest) Reset()         { *m = QueryVolunteerValidatorTermRequest{} }
+func (m *QueryVolunteerValidatorTermRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVolunteerValidatorTermRequest) ProtoMessage()    {}
+func (*QueryVolunteerValidatorTermRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66f35a978c82749b, []int{4}
+}
+func (m *QueryVolunteerValidatorTermRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVolunteerValidatorTermRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVolunteerValidatorTermRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVolunteerValidatorTermRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVolunteerValidatorTermRequest.Merge(m, src)
+}
+func (m *QueryVolunteerValidatorTermRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVolunteerValidatorTermRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVolunteerValidatorTermRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVolunteerValidatorTermRequest proto.InternalMessageInfo
+
+func (m *QueryVolunteerValidatorTermRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryVolunteerValidatorTermResponse is the response type for the
+// Query/VolunteerValidatorTerm RPC method.
+type QueryVolunteerValidatorTermResponse struct {
+	// term_end_height is the block height at which the validator is
+	// unregistered. Zero means no term limit.
+	TermEndHeight int64 `protobuf:"varint,1,opt,name=term_end_height,json=termEndHeight,proto3" json:"term_end_height,omitempty"`
+	// remaining_blocks is the number of blocks until the end of the term.
+	RemainingBlocks int64 `protobuf:"varint,2,opt,name=remaining_blocks,json=remainingBlocks,proto3" json:"remaining_blocks,omitempty"`
+}
+
+func (m *QueryVolunteerValidatorTermResponse) Reset()         { *m = QueryVolunteerValidatorTermResponse{} }
+func (m *QueryVolunteerValidatorTermResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVolunteerValidatorTermResponse) ProtoMessage()    {}
+func (*QueryVolunteerValidatorTermResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66f35a978c82749b, []int{5}
+}
+func (m *QueryVolunteerValidatorTermResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVolunteerValidatorTermResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVolunteerValidatorTermResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVolunteerValidatorTermResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVolunteerValidatorTermResponse.Merge(m, src)
+}
+func (m *QueryVolunteerValidatorTermResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVolunteerValidatorTermResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVolunteerValidatorTermResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVolunteerValidatorTermResponse proto.InternalMessageInfo
+
+func (m *QueryVolunteerValidatorTermResponse) GetTermEndHeight() int64 {
+	if m != nil {
+		return m.TermEndHeight
+	}
+	return 0
+}
+
+func (m *QueryVolunteerValidatorTermResponse) GetRemainingBlocks() int64 {
+	if m != nil {
+		return m.RemainingBlocks
+	}
+	return 0
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66f35a978c82749b, []int{6}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66f35a978c82749b, []int{7}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryVolunteerValidatorsRequest)(nil), "xpla.volunteer.v1beta1.QueryVolunteerValidatorsRequest")
 	proto.RegisterType((*QueryVolunteerValidatorsResponse)(nil), "xpla.volunteer.v1beta1.QueryVolunteerValidatorsResponse")
+	proto.RegisterType((*QueryVolunteerValidatorRequest)(nil), "xpla.volunteer.v1beta1.QueryVolunteerValidatorRequest")
+	proto.RegisterType((*QueryVolunteerValidatorResponse)(nil), "xpla.volunteer.v1beta1.QueryVolunteerValidatorResponse")
+	proto.RegisterType((*QueryVolunteerValidatorTermRequest)(nil), "xpla.volunteer.v1beta1.QueryVolunteerValidatorTermRequest")
+	proto.RegisterType((*QueryVolunteerValidatorTermResponse)(nil), "xpla.volunteer.v1beta1.QueryVolunteerValidatorTermResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "xpla.volunteer.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "xpla.volunteer.v1beta1.QueryParamsResponse")
 }
 
 func init() {
@@ -120,24 +437,48 @@ func init() {
 }
 
 var fileDescriptor_66f35a978c82749b = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xaa, 0x28, 0xc8, 0x49,
-	0xd4, 0x2f, 0xcb, 0xcf, 0x29, 0xcd, 0x2b, 0x49, 0x4d, 0x2d, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x03, 0xa9, 0xd1, 0x83, 0xab, 0xd1, 0x83, 0xaa, 0x91, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49,
-	0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b,
-	0x86, 0xe8, 0x52, 0x52, 0xe4, 0x92, 0x0f, 0x04, 0x19, 0x12, 0x06, 0xd3, 0x17, 0x96, 0x98, 0x93,
-	0x99, 0x92, 0x58, 0x92, 0x5f, 0x54, 0x1c, 0x94, 0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0xa2, 0x14, 0xca,
-	0xa5, 0x80, 0x5b, 0x49, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x21, 0x97, 0x08, 0xdc, 0xe6,
-	0xf8, 0x32, 0xb8, 0xbc, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0x67, 0x90, 0x70, 0x19, 0xa6, 0x56, 0xa3,
-	0x7d, 0x8c, 0x5c, 0xac, 0x60, 0x73, 0x85, 0xb6, 0x30, 0x72, 0x09, 0x63, 0x31, 0x5c, 0xc8, 0x5c,
-	0x0f, 0xbb, 0x97, 0xf4, 0x08, 0xb8, 0x58, 0xca, 0x82, 0x74, 0x8d, 0x10, 0x7f, 0x28, 0x69, 0x35,
-	0x5d, 0x7e, 0x32, 0x99, 0x49, 0x45, 0x48, 0x49, 0x1f, 0x47, 0x88, 0x23, 0xfc, 0xe6, 0xe4, 0x7c,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0x60, 0x73, 0x52, 0x52, 0xcb, 0x20, 0xe6, 0x55, 0x20, 0x99, 0x58,
-	0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x06, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x22, 0x2c, 0x22, 0xf6, 0xe2, 0x01, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6b, 0x13, 0x4f,
+	0x14, 0xcf, 0x34, 0xb4, 0x90, 0x29, 0x5f, 0xda, 0x4e, 0x4b, 0x29, 0xe1, 0xcb, 0x36, 0xac, 0x12,
+	0xd3, 0x14, 0x76, 0x49, 0x84, 0xb6, 0x58, 0x14, 0x9a, 0xe2, 0x8f, 0x8b, 0xd0, 0xae, 0x5a, 0xc4,
+	0x4b, 0x98, 0x64, 0x87, 0xcd, 0x62, 0x76, 0x67, 0xbb, 0xb3, 0x59, 0x52, 0xc4, 0x8b, 0x37, 0x6f,
+	0x82, 0xff, 0x84, 0x47, 0x0f, 0xfe, 0x0b, 0x42, 0x8f, 0x05, 0x41, 0x3c, 0x89, 0x24, 0x82, 0x07,
+	0xcf, 0xde, 0x65, 0x67, 0x26, 0x9b, 0x0d, 0xc9, 0x36, 0x26, 0x97, 0x10, 0xde, 0xbc, 0xcf, 0xaf,
+	0x37, 0x6f, 0x12, 0xa8, 0x76, 0xbd, 0x36, 0xd6, 0x43, 0xda, 0xee, 0xb8, 0x01, 0x21, 0xbe, 0x1e,
+	0x56, 0x1a, 0x24, 0xc0, 0x15, 0xfd, 0xbc, 0x43, 0xfc, 0x0b, 0xcd, 0xf3, 0x69, 0x40, 0xd1, 0x66,
+	0xd4, 0xa3, 0xc5, 0x3d, 0x9a, 0xec, 0xc9, 0x6f, 0x58, 0xd4, 0xa2, 0xbc, 0x45, 0x8f, 0xbe, 0x89,
+	0xee, 0xfc, 0xff, 0x16, 0xa5, 0x56, 0x9b, 0xe8, 0xd8, 0xb3, 0x75, 0xec, 0xba, 0x34, 0xc0, 0x81,
+	0x4d, 0x5d, 0x26, 0x4f, 0xcb, 0x4d, 0xca, 0x1c, 0xca, 0xf4, 0x06, 0x66, 0x44, 0x88, 0xc4, 0x92,
+	0x1e, 0xb6, 0x6c, 0x97, 0x37, 0xcb, 0x5e, 0x3d, 0xc5, 0x5b, 0x5c, 0x09, 0x71, 0xdb, 0x36, 0x71,
+	0x40, 0x7d, 0x09, 0x58, 0xc3, 0x8e, 0xed, 0x52, 0x9d, 0x7f, 0x8a, 0x92, 0x6a, 0xc3, 0xed, 0xd3,
+	0x48, 0xe5, 0x6c, 0x80, 0x39, 0x1b, 0x60, 0x98, 0x41, 0xce, 0x3b, 0x84, 0x05, 0xe8, 0x01, 0x84,
+	0x43, 0xe9, 0x2d, 0x50, 0x00, 0xa5, 0xe5, 0x6a, 0x51, 0x13, 0x3e, 0xb5, 0xc8, 0xa7, 0x26, 0x86,
+	0x21, 0xe5, 0xb5, 0x13, 0x6c, 0x11, 0x89, 0x35, 0x12, 0x48, 0xf5, 0x0f, 0x80, 0x85, 0x74, 0x2d,
+	0xe6, 0x51, 0x97, 0x11, 0x54, 0x81, 0x1b, 0xb1, 0xfd, 0x7a, 0xec, 0x9f, 0x6d, 0x81, 0x42, 0xb6,
+	0x94, 0x33, 0xd6, 0xc3, 0x71, 0x28, 0x7a, 0x06, 0x61, 0xa2, 0x71, 0xa1, 0x90, 0x2d, 0x2d, 0x57,
+	0xcb, 0xda, 0xe4, 0x3b, 0xd1, 0xc6, 0xb5, 0x6b, 0xb9, 0xcb, 0xef, 0xdb, 0x99, 0x0f, 0xbf, 0x3e,
+	0x96, 0x81, 0x91, 0x20, 0x42, 0x0f, 0x47, 0x62, 0x67, 0x79, 0xec, 0x5b, 0x53, 0x63, 0x8b, 0x18,
+	0x23, 0xb9, 0x1f, 0x43, 0x25, 0x25, 0xf6, 0x60, 0xc2, 0xbb, 0x70, 0x2d, 0x16, 0xae, 0x63, 0xd3,
+	0xf4, 0x09, 0x63, 0x7c, 0xd0, 0x39, 0x63, 0x35, 0x3e, 0x38, 0x12, 0x75, 0x35, 0x4c, 0xbd, 0xb1,
+	0x78, 0x88, 0x4f, 0x60, 0x2e, 0x86, 0xc9, 0x0b, 0x9b, 0x73, 0x20, 0x43, 0x1e, 0xf5, 0x14, 0xaa,
+	0x29, 0xba, 0x4f, 0x89, 0xef, 0xcc, 0x15, 0xa5, 0x0b, 0x6f, 0x5c, 0x4b, 0x29, 0xe3, 0x14, 0xe1,
+	0x4a, 0x40, 0x7c, 0xa7, 0x4e, 0x5c, 0xb3, 0xde, 0x22, 0xb6, 0xd5, 0x0a, 0x38, 0x63, 0xd6, 0xf8,
+	0x2f, 0x2a, 0xdf, 0x77, 0xcd, 0x47, 0xbc, 0x88, 0x76, 0xe0, 0xaa, 0x4f, 0x1c, 0x6c, 0xbb, 0xb6,
+	0x6b, 0xd5, 0x1b, 0x6d, 0xda, 0x7c, 0x19, 0xad, 0x43, 0xd4, 0xb8, 0x12, 0xd7, 0x6b, 0xbc, 0xac,
+	0x6e, 0x40, 0xc4, 0x95, 0x4f, 0xb0, 0x8f, 0x9d, 0xc1, 0xa6, 0xab, 0xcf, 0xe1, 0xfa, 0x48, 0x55,
+	0xea, 0x1f, 0xc1, 0x25, 0x8f, 0x57, 0xe4, 0x2c, 0x95, 0xb4, 0x59, 0x0a, 0x5c, 0x72, 0x7e, 0x12,
+	0x58, 0xfd, 0xbd, 0x08, 0x17, 0x39, 0x35, 0xfa, 0x04, 0xe0, 0xfa, 0x84, 0x07, 0x80, 0xf6, 0xd3,
+	0x48, 0xa7, 0x3c, 0xcf, 0xfc, 0xc1, 0xec, 0x40, 0x91, 0x4b, 0x2d, 0xbf, 0xf9, 0xf2, 0xf3, 0xfd,
+	0xc2, 0x4d, 0xa4, 0xa6, 0xfe, 0x90, 0x0c, 0xed, 0x7d, 0x06, 0x10, 0x8d, 0x73, 0xa1, 0xbd, 0x19,
+	0xc5, 0x07, 0xa6, 0xf7, 0x67, 0xc6, 0x49, 0xcf, 0xf7, 0xb8, 0xe7, 0x03, 0xb4, 0x37, 0xdd, 0xb3,
+	0xfe, 0x6a, 0x6c, 0x13, 0x5f, 0xa3, 0xaf, 0x00, 0x6e, 0x4e, 0x5e, 0x37, 0x74, 0x67, 0x46, 0x4f,
+	0x89, 0xb5, 0xcf, 0x1f, 0xce, 0x85, 0x95, 0x99, 0x8e, 0x79, 0xa6, 0xbb, 0xe8, 0x70, 0xbe, 0x4c,
+	0x7a, 0xf4, 0x0a, 0xd0, 0x5b, 0x00, 0x97, 0xc4, 0xfe, 0xa1, 0xf2, 0xb5, 0x66, 0x46, 0x56, 0x3e,
+	0xbf, 0xfb, 0x4f, 0xbd, 0xd2, 0x68, 0x91, 0x1b, 0x2d, 0x20, 0x25, 0xcd, 0xa8, 0xd8, 0xf6, 0xda,
+	0xf1, 0x65, 0x4f, 0x01, 0x57, 0x3d, 0x05, 0xfc, 0xe8, 0x29, 0xe0, 0x5d, 0x5f, 0xc9, 0x5c, 0xf5,
+	0x95, 0xcc, 0xb7, 0xbe, 0x92, 0x79, 0xb1, 0x63, 0xd9, 0x41, 0xab, 0xd3, 0xd0, 0x9a, 0xd4, 0xe1,
+	0x1c, 0x26, 0x09, 0x05, 0x57, 0x37, 0xc1, 0x16, 0x5c, 0x78, 0x84, 0x35, 0x96, 0xf8, 0x1f, 0xd4,
+	0xed, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xab, 0x6c, 0x92, 0x3c, 0x82, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -154,6 +495,13 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// VolunteerValidators
 	VolunteerValidators(ctx context.Context, in *QueryVolunteerValidatorsRequest, opts ...grpc.CallOption) (*QueryVolunteerValidatorsResponse, error)
+	// VolunteerValidator queries a volunteer validator by its address.
+	VolunteerValidator(ctx context.Context, in *QueryVolunteerValidatorRequest, opts ...grpc.CallOption) (*QueryVolunteerValidatorResponse, error)
+	// VolunteerValidatorTerm queries the remaining term of a volunteer
+	// validator.
+	VolunteerValidatorTerm(ctx context.Context, in *QueryVolunteerValidatorTermRequest, opts ...grpc.CallOption) (*QueryVolunteerValidatorTermResponse, error)
+	// Params queries params of the volunteer module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -173,10 +521,44 @@ func (c *queryClient) VolunteerValidators(ctx context.Context, in *QueryVoluntee
 	return out, nil
 }
 
+func (c *queryClient) VolunteerValidator(ctx context.Context, in *QueryVolunteerValidatorRequest, opts ...grpc.CallOption) (*QueryVolunteerValidatorResponse, error) {
+	out := new(QueryVolunteerValidatorResponse)
+	err := c.cc.Invoke(ctx, "/xpla.volunteer.v1beta1.Query/VolunteerValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VolunteerValidatorTerm(ctx context.Context, in *QueryVolunteerValidatorTermRequest, opts ...grpc.CallOption) (*QueryVolunteerValidatorTermResponse, error) {
+	out := new(QueryVolunteerValidatorTermResponse)
+	err := c.cc.Invoke(ctx, "/xpla.volunteer.v1beta1.Query/VolunteerValidatorTerm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/xpla.volunteer.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// VolunteerValidators
 	VolunteerValidators(context.Context, *QueryVolunteerValidatorsRequest) (*QueryVolunteerValidatorsResponse, error)
+	// VolunteerValidator queries a volunteer validator by its address.
+	VolunteerValidator(context.Context, *QueryVolunteerValidatorRequest) (*QueryVolunteerValidatorResponse, error)
+	// VolunteerValidatorTerm queries the remaining term of a volunteer
+	// validator.
+	VolunteerValidatorTerm(context.Context, *QueryVolunteerValidatorTermRequest) (*QueryVolunteerValidatorTermResponse, error)
+	// Params queries params of the volunteer module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -186,6 +568,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) VolunteerValidators(ctx context.Context, req *QueryVolunteerValidatorsRequest) (*QueryVolunteerValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VolunteerValidators not implemented")
 }
+func (*UnimplementedQueryServer) VolunteerValidator(ctx context.Context, req *QueryVolunteerValidatorRequest) (*QueryVolunteerValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolunteerValidator not implemented")
+}
+func (*UnimplementedQueryServer) VolunteerValidatorTerm(ctx context.Context, req *QueryVolunteerValidatorTermRequest) (*QueryVolunteerValidatorTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolunteerValidatorTerm not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -209,6 +600,60 @@ func _Query_VolunteerValidators_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VolunteerValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVolunteerValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VolunteerValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.volunteer.v1beta1.Query/VolunteerValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VolunteerValidator(ctx, req.(*QueryVolunteerValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VolunteerValidatorTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVolunteerValidatorTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VolunteerValidatorTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.volunteer.v1beta1.Query/VolunteerValidatorTerm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VolunteerValidatorTerm(ctx, req.(*QueryVolunteerValidatorTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.volunteer.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "xpla.volunteer.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -217,6 +662,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VolunteerValidators",
 			Handler:    _Query_VolunteerValidators_Handler,
 		},
+		{
+			MethodName: "VolunteerValidator",
+			Handler:    _Query_VolunteerValidator_Handler,
+		},
+		{
+			MethodName: "VolunteerValidatorTerm",
+			Handler:    _Query_VolunteerValidatorTerm_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xpla/volunteer/v1beta1/query.proto",
@@ -242,6 +699,18 @@ func (m *QueryVolunteerValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -265,6 +734,32 @@ func (m *QueryVolunteerValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.VolunteerValidators) > 0 {
 		for iNdEx := len(m.VolunteerValidators) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.VolunteerValidators[iNdEx])
@@ -277,16 +772,198 @@ func (m *QueryVolunteerValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryVolunteerValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolunteerValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolunteerValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVolunteerValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolunteerValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolunteerValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVolunteerValidatorTermRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolunteerValidatorTermRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolunteerValidatorTermRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVolunteerValidatorTermResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolunteerValidatorTermResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolunteerValidatorTermResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TermEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TermEndHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryVolunteerValidatorsRequest) Size() (n int) {
 	if m == nil {
@@ -294,6 +971,10 @@ func (m *QueryVolunteerValidatorsRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -309,6 +990,88 @@ func (m *QueryVolunteerValidatorsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVolunteerValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVolunteerValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVolunteerValidatorTermRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVolunteerValidatorTermResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TermEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.TermEndHeight))
+	}
+	if m.RemainingBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingBlocks))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -347,6 +1110,42 @@ func (m *QueryVolunteerValidatorsRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryVolunteerValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -429,6 +1228,544 @@ func (m *QueryVolunteerValidatorsResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.VolunteerValidators = append(m.VolunteerValidators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, VolunteerValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVolunteerValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVolunteerValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVolunteerValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVolunteerValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVolunteerValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVolunteerValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVolunteerValidatorTermRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVolunteerValidatorTermRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVolunteerValidatorTermRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVolunteerValidatorTermResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVolunteerValidatorTermResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVolunteerValidatorTermResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermEndHeight", wireType)
+			}
+			m.TermEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TermEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBlocks", wireType)
+			}
+			m.RemainingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -44,6 +44,13 @@ type MsgRegisterVolunteerValidator struct {
 	ValidatorAddress     string            `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
 	Pubkey               *types1.Any       `protobuf:"bytes,5,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	Amount               types2.Coin       `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount"`
+	// operator_contact is the contact of the validator operator.
+	OperatorContact string `protobuf:"bytes,7,opt,name=operator_contact,json=operatorContact,proto3" json:"operator_contact,omitempty"`
+	// operator_description is the description of the validator operator.
+	OperatorDescription string `protobuf:"bytes,8,opt,name=operator_description,json=operatorDescription,proto3" json:"operator_description,omitempty"`
+	// commission_destination defines where the commission of the validator is
+	// sent.
+	CommissionDestination CommissionDestination `protobuf:"bytes,9,opt,name=commission_destination,json=commissionDestination,proto3" json:"commission_destination"`
 }
 
 func (m *MsgRegisterVolunteerValidator) Reset()         { *m = MsgRegisterVolunteerValidator{} }
@@ -121,6 +128,27 @@ func (m *MsgRegisterVolunteerValidator) GetAmount() types2.Coin {
 	return types2.Coin{}
 }
 
+func (m *MsgRegisterVolunteerValidator) GetOperatorContact() string {
+	if m != nil {
+		return m.OperatorContact
+	}
+	return ""
+}
+
+func (m *MsgRegisterVolunteerValidator) GetOperatorDescription() string {
+	if m != nil {
+		return m.OperatorDescription
+	}
+	return ""
+}
+
+func (m *MsgRegisterVolunteerValidator) GetCommissionDestination() CommissionDestination {
+	if m != nil {
+		return m.CommissionDestination
+	}
+	return CommissionDestination{}
+}
+
 // MsgRegisterVolunteerValidatorResponse defines the RegisterVolunteerValidator
 // response.
 type MsgRegisterVolunteerValidatorResponse struct {
@@ -254,55 +282,272 @@ func (m *MsgUnregisterVolunteerValidatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnregisterVolunteerValidatorResponse proto.InternalMessageInfo
 
+// MsgUpdateCommissionDestination defines a message to update where the
+// commission of a volunteer validator is sent.
+type MsgUpdateCommissionDestination struct {
+	// authority is the address of the governance account.
+	Authority        string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// commission_destination defines where the commission of the validator is
+	// sent.
+	CommissionDestination CommissionDestination `protobuf:"bytes,3,opt,name=commission_destination,json=commissionDestination,proto3" json:"commission_destination"`
+}
+
+func (m *MsgUpdateCommissionDestination) Reset()         { *m = MsgUpdateCommissionDestination{} }
+func (m *MsgUpdateCommissionDestination) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCommissionDestination) ProtoMessage()    {}
+func (*MsgUpdateCommissionDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae62d0c27add756a, []int{4}
+}
+func (m *MsgUpdateCommissionDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCommissionDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCommissionDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCommissionDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCommissionDestination.Merge(m, src)
+}
+func (m *MsgUpdateCommissionDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCommissionDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCommissionDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCommissionDestination proto.InternalMessageInfo
+
+func (m *MsgUpdateCommissionDestination) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateCommissionDestination) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateCommissionDestination) GetCommissionDestination() CommissionDestination {
+	if m != nil {
+		return m.CommissionDestination
+	}
+	return CommissionDestination{}
+}
+
+// MsgUpdateCommissionDestinationResponse defines the
+// UpdateCommissionDestination response.
+type MsgUpdateCommissionDestinationResponse struct {
+}
+
+func (m *MsgUpdateCommissionDestinationResponse) Reset() {
+	*m = MsgUpdateCommissionDestinationResponse{}
+}
+func (m *MsgUpdateCommissionDestinationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCommissionDestinationResponse) ProtoMessage()    {}
+func (*MsgUpdateCommissionDestinationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae62d0c27add756a, []int{5}
+}
+func (m *MsgUpdateCommissionDestinationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCommissionDestinationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCommissionDestinationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCommissionDestinationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCommissionDestinationResponse.Merge(m, src)
+}
+func (m *MsgUpdateCommissionDestinationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCommissionDestinationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCommissionDestinationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCommissionDestinationResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type for volunteer
+// parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/volunteer parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae62d0c27add756a, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae62d0c27add756a, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterVolunteerValidator)(nil), "xpla.volunteer.v1beta1.MsgRegisterVolunteerValidator")
 	proto.RegisterType((*MsgRegisterVolunteerValidatorResponse)(nil), "xpla.volunteer.v1beta1.MsgRegisterVolunteerValidatorResponse")
 	proto.RegisterType((*MsgUnregisterVolunteerValidator)(nil), "xpla.volunteer.v1beta1.MsgUnregisterVolunteerValidator")
 	proto.RegisterType((*MsgUnregisterVolunteerValidatorResponse)(nil), "xpla.volunteer.v1beta1.MsgUnregisterVolunteerValidatorResponse")
+	proto.RegisterType((*MsgUpdateCommissionDestination)(nil), "xpla.volunteer.v1beta1.MsgUpdateCommissionDestination")
+	proto.RegisterType((*MsgUpdateCommissionDestinationResponse)(nil), "xpla.volunteer.v1beta1.MsgUpdateCommissionDestinationResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "xpla.volunteer.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "xpla.volunteer.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("xpla/volunteer/v1beta1/tx.proto", fileDescriptor_ae62d0c27add756a) }
 
 var fileDescriptor_ae62d0c27add756a = []byte{
-	// 604 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6b, 0xd4, 0x40,
-	0x18, 0xde, 0xf4, 0x63, 0xa1, 0xe3, 0xa5, 0x0d, 0xab, 0xa6, 0xa1, 0x66, 0x4b, 0xb4, 0xf4, 0x03,
-	0x9c, 0xd0, 0x8a, 0x1f, 0x2c, 0x8a, 0x74, 0x2b, 0x82, 0x48, 0x41, 0x22, 0xf6, 0xe0, 0xa5, 0x4c,
-	0x92, 0x71, 0x1a, 0x9a, 0x64, 0x42, 0x66, 0xb2, 0x34, 0x37, 0xf1, 0xe8, 0x49, 0x7a, 0xf2, 0x27,
-	0x78, 0xec, 0xc1, 0xa3, 0x3f, 0xa0, 0x78, 0x2a, 0x9e, 0x3c, 0x95, 0xd2, 0x3d, 0xec, 0xdd, 0x5f,
-	0x20, 0x49, 0x66, 0xb2, 0xab, 0x5b, 0x22, 0x2c, 0x5e, 0x76, 0x77, 0xe6, 0x7d, 0x9e, 0x67, 0x9f,
-	0x79, 0xde, 0x77, 0x06, 0xb4, 0x8f, 0xe2, 0x00, 0x59, 0x3d, 0x1a, 0xa4, 0x11, 0xc7, 0x38, 0xb1,
-	0x7a, 0x9b, 0x0e, 0xe6, 0x68, 0xd3, 0xe2, 0x47, 0x30, 0x4e, 0x28, 0xa7, 0xea, 0x8d, 0x1c, 0x00,
-	0x2b, 0x00, 0x14, 0x00, 0xbd, 0x45, 0x28, 0xa1, 0x05, 0xc4, 0xca, 0x7f, 0x95, 0x68, 0x7d, 0x91,
-	0x50, 0x4a, 0x02, 0x6c, 0x15, 0x2b, 0x27, 0x7d, 0x67, 0xa1, 0x28, 0x93, 0x25, 0x97, 0xb2, 0x90,
-	0xb2, 0xfd, 0x92, 0x53, 0x2e, 0x44, 0xc9, 0x28, 0x57, 0x96, 0x83, 0x18, 0xae, 0x1c, 0xb8, 0xd4,
-	0x8f, 0x44, 0xfd, 0x8e, 0xa8, 0x33, 0x8e, 0x0e, 0xfd, 0x88, 0x54, 0x10, 0xb1, 0x16, 0xa8, 0x05,
-	0x14, 0xfa, 0x11, 0xb5, 0x8a, 0x4f, 0xb1, 0x75, 0x53, 0x10, 0x43, 0x96, 0x93, 0xf2, 0xaf, 0xb2,
-	0x60, 0x1e, 0xcf, 0x80, 0x5b, 0xbb, 0x8c, 0xd8, 0x98, 0xf8, 0x8c, 0xe3, 0x64, 0x4f, 0x1e, 0x6f,
-	0x0f, 0x05, 0xbe, 0x87, 0x38, 0x4d, 0xd4, 0x07, 0x60, 0x0e, 0xa5, 0xfc, 0x80, 0x26, 0x3e, 0xcf,
-	0x34, 0x65, 0x59, 0x59, 0x9b, 0xeb, 0x6a, 0x3f, 0xbe, 0xde, 0x6d, 0x09, 0xe3, 0xdb, 0x9e, 0x97,
-	0x60, 0xc6, 0x5e, 0xf3, 0xc4, 0x8f, 0x88, 0x3d, 0x84, 0xaa, 0x2e, 0xb8, 0xde, 0x93, 0x22, 0xfb,
-	0x1e, 0x66, 0x6e, 0xe2, 0xc7, 0xdc, 0xa7, 0x91, 0x36, 0xb5, 0xac, 0xac, 0x5d, 0xdb, 0xba, 0x0d,
-	0x85, 0x80, 0xf4, 0x2e, 0xce, 0x02, 0x9f, 0x0d, 0xa1, 0xdd, 0xb9, 0xd3, 0xf3, 0x76, 0xe3, 0xcb,
-	0xe0, 0x64, 0x43, 0xb1, 0x5b, 0x95, 0xd8, 0x08, 0x40, 0x7d, 0x01, 0x16, 0x3c, 0x1c, 0x60, 0x52,
-	0xfc, 0x09, 0x2a, 0xad, 0x68, 0xd3, 0x85, 0xc9, 0xa5, 0x5f, 0xe7, 0x6d, 0x2d, 0x43, 0x61, 0xd0,
-	0x31, 0xc7, 0x20, 0xa6, 0x3d, 0x5f, 0xed, 0x89, 0x03, 0xe4, 0x52, 0x43, 0xbf, 0x52, 0x6a, 0xe6,
-	0x6f, 0xa9, 0x31, 0x88, 0x69, 0xcf, 0x57, 0x7b, 0x52, 0xea, 0x39, 0x68, 0xc6, 0xa9, 0x73, 0x88,
-	0x33, 0x6d, 0xb6, 0x38, 0x6b, 0x0b, 0x96, 0xd3, 0x00, 0xe5, 0x34, 0xc0, 0xed, 0x28, 0xeb, 0x6a,
-	0xdf, 0x87, 0x29, 0xba, 0x49, 0x16, 0x73, 0x0a, 0x5f, 0xa5, 0xce, 0x4b, 0x9c, 0xd9, 0x82, 0xad,
-	0x3e, 0x06, 0x4d, 0x14, 0xd2, 0x34, 0xe2, 0x5a, 0xb3, 0xd0, 0x59, 0x94, 0x99, 0xe5, 0xf3, 0x51,
-	0x05, 0xb6, 0x43, 0xfd, 0x3f, 0x92, 0x12, 0x9c, 0xce, 0xa3, 0x0f, 0x83, 0x93, 0x8d, 0x61, 0x43,
-	0x3e, 0x0e, 0x4e, 0x36, 0x56, 0xf2, 0x19, 0xf6, 0x70, 0xcf, 0xaa, 0x6d, 0xb9, 0xb9, 0x0a, 0x56,
-	0x6a, 0x01, 0x36, 0x66, 0x31, 0x8d, 0x18, 0x36, 0x2f, 0x14, 0xd0, 0xde, 0x65, 0xe4, 0x4d, 0x94,
-	0xfc, 0xff, 0xf9, 0xb9, 0xb2, 0x1f, 0x53, 0x93, 0xf4, 0xa3, 0xd3, 0x19, 0x4f, 0x62, 0x75, 0x24,
-	0x89, 0x3a, 0xfb, 0xe6, 0x3a, 0x58, 0xfd, 0x07, 0x44, 0xa6, 0xb1, 0xf5, 0x6d, 0x0a, 0x4c, 0xef,
-	0x32, 0xa2, 0x1e, 0x2b, 0x40, 0xaf, 0xb9, 0x50, 0xf7, 0xe1, 0xd5, 0x2f, 0x09, 0xac, 0xcd, 0x5c,
-	0x7f, 0x32, 0x11, 0x4d, 0x9a, 0x53, 0x3f, 0x2b, 0x60, 0xa9, 0xb6, 0x4f, 0x0f, 0x6b, 0xf4, 0xeb,
-	0x88, 0xfa, 0xd3, 0x09, 0x89, 0xd2, 0x9a, 0x3e, 0xfb, 0x3e, 0x9f, 0xdb, 0xee, 0xce, 0xe9, 0xa5,
-	0xa1, 0x9c, 0x5d, 0x1a, 0xca, 0xc5, 0xa5, 0xa1, 0x7c, 0xea, 0x1b, 0x8d, 0xb3, 0xbe, 0xd1, 0xf8,
-	0xd9, 0x37, 0x1a, 0x6f, 0xd7, 0x89, 0xcf, 0x0f, 0x52, 0x07, 0xba, 0x34, 0xb4, 0x64, 0xdf, 0x8a,
-	0xe7, 0xfa, 0x68, 0xe4, 0xc1, 0xe6, 0x59, 0x8c, 0x99, 0xd3, 0x2c, 0xae, 0xd8, 0xbd, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x53, 0x1c, 0x7b, 0xef, 0xcf, 0x05, 0x00, 0x00,
+	// 815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x4f, 0xdb, 0x48,
+	0x18, 0x8d, 0xf9, 0x91, 0xdd, 0xcc, 0xae, 0x04, 0x78, 0x03, 0x18, 0x2f, 0xeb, 0x20, 0xb3, 0x10,
+	0x40, 0xc2, 0x16, 0xac, 0x96, 0xdd, 0x8d, 0x76, 0xb7, 0x22, 0x41, 0x95, 0xaa, 0x0a, 0x09, 0xb9,
+	0x2a, 0x87, 0x5e, 0xd0, 0xc4, 0x99, 0x1a, 0x8b, 0xd8, 0x63, 0x79, 0x26, 0x11, 0xbe, 0x55, 0x3d,
+	0xf6, 0x54, 0x55, 0xaa, 0xd4, 0x3f, 0xa1, 0x47, 0x0e, 0x3d, 0xf7, 0xd4, 0x03, 0xea, 0xa5, 0xa8,
+	0xa7, 0x9e, 0x10, 0x85, 0x03, 0xf7, 0xfe, 0x05, 0x95, 0xed, 0xf1, 0xc4, 0x90, 0xc4, 0x54, 0x51,
+	0x2b, 0xf5, 0x02, 0xcc, 0x37, 0xef, 0x7d, 0xf3, 0xbe, 0xe7, 0x37, 0x36, 0xa0, 0x74, 0xe8, 0x35,
+	0xa1, 0xde, 0xc6, 0xcd, 0x96, 0x4b, 0x11, 0xf2, 0xf5, 0xf6, 0x5a, 0x1d, 0x51, 0xb8, 0xa6, 0xd3,
+	0x43, 0xcd, 0xf3, 0x31, 0xc5, 0xe2, 0x54, 0x08, 0xd0, 0x38, 0x40, 0x63, 0x00, 0xb9, 0x68, 0x61,
+	0x0b, 0x47, 0x10, 0x3d, 0xfc, 0x2b, 0x46, 0xcb, 0x33, 0x16, 0xc6, 0x56, 0x13, 0xe9, 0xd1, 0xaa,
+	0xde, 0x7a, 0xa8, 0x43, 0x37, 0x48, 0xb6, 0x4c, 0x4c, 0x1c, 0x4c, 0xf6, 0x62, 0x4e, 0xbc, 0x60,
+	0x5b, 0x4a, 0xbc, 0xd2, 0xeb, 0x90, 0x20, 0xae, 0xc0, 0xc4, 0xb6, 0xcb, 0xf6, 0x7f, 0x67, 0xfb,
+	0x84, 0xc2, 0x03, 0xdb, 0xb5, 0x38, 0x84, 0xad, 0x19, 0x6a, 0x02, 0x3a, 0xb6, 0x8b, 0xf5, 0xe8,
+	0x27, 0x2b, 0x4d, 0x33, 0xa2, 0x43, 0x42, 0x52, 0xf8, 0x8b, 0x6d, 0xe8, 0x7d, 0xc6, 0xe6, 0x95,
+	0x36, 0x6c, 0xda, 0x0d, 0x48, 0xb1, 0x1f, 0x13, 0xd4, 0x8f, 0xa3, 0xe0, 0xb7, 0x6d, 0x62, 0x19,
+	0xc8, 0xb2, 0x09, 0x45, 0xfe, 0x6e, 0x82, 0xdb, 0x4d, 0x70, 0xe2, 0x06, 0x28, 0xc0, 0x16, 0xdd,
+	0xc7, 0xbe, 0x4d, 0x03, 0x49, 0x98, 0x13, 0x96, 0x0a, 0x55, 0xe9, 0xfd, 0xab, 0xd5, 0x22, 0x9b,
+	0x74, 0xb3, 0xd1, 0xf0, 0x11, 0x21, 0xf7, 0xa8, 0x6f, 0xbb, 0x96, 0xd1, 0x81, 0x8a, 0x26, 0x98,
+	0xe4, 0x87, 0xed, 0x35, 0x10, 0x31, 0x7d, 0xdb, 0xa3, 0x36, 0x76, 0xa5, 0xa1, 0x39, 0x61, 0xe9,
+	0xa7, 0xf5, 0x79, 0x8d, 0x35, 0x48, 0x86, 0x65, 0x52, 0xb5, 0xad, 0x0e, 0xb4, 0x5a, 0x38, 0x3e,
+	0x2d, 0xe5, 0x5e, 0x5e, 0x1e, 0xad, 0x08, 0x46, 0x91, 0x37, 0x4b, 0x01, 0xc4, 0x3b, 0x60, 0xa2,
+	0x81, 0x9a, 0xc8, 0x8a, 0x0e, 0x81, 0xb1, 0x14, 0x69, 0x38, 0x12, 0x39, 0xfb, 0xe9, 0xb4, 0x24,
+	0x05, 0xd0, 0x69, 0x56, 0xd4, 0x2e, 0x88, 0x6a, 0x8c, 0xf3, 0x1a, 0x1b, 0x20, 0x6c, 0xd5, 0xd1,
+	0x9b, 0xb4, 0x1a, 0xb9, 0xde, 0xaa, 0x0b, 0xa2, 0x1a, 0xe3, 0xbc, 0x96, 0xb4, 0xba, 0x0d, 0xf2,
+	0x5e, 0xab, 0x7e, 0x80, 0x02, 0x69, 0x34, 0x9a, 0xb5, 0xa8, 0xc5, 0xf1, 0xd1, 0x92, 0xf8, 0x68,
+	0x9b, 0x6e, 0x50, 0x95, 0xde, 0x76, 0x5c, 0x34, 0xfd, 0xc0, 0xa3, 0x58, 0xdb, 0x69, 0xd5, 0xef,
+	0xa2, 0xc0, 0x60, 0x6c, 0xf1, 0x5f, 0x90, 0x87, 0x0e, 0x6e, 0xb9, 0x54, 0xca, 0x47, 0x7d, 0x66,
+	0x12, 0xcf, 0xc2, 0x40, 0x71, 0xc3, 0x6a, 0xd8, 0xbe, 0xe2, 0x14, 0xe3, 0x88, 0xcb, 0x60, 0x1c,
+	0x7b, 0xc8, 0x8f, 0xc4, 0x9a, 0xd8, 0xa5, 0xd0, 0xa4, 0xd2, 0x0f, 0xe1, 0x3c, 0xc6, 0x58, 0x52,
+	0xaf, 0xc5, 0x65, 0x71, 0x0d, 0x14, 0x39, 0x34, 0xfd, 0xa8, 0x7e, 0x8c, 0xe0, 0xbf, 0x24, 0x7b,
+	0x69, 0xe7, 0x31, 0x98, 0x32, 0xb1, 0xe3, 0xd8, 0x84, 0xd8, 0xd8, 0x0d, 0x49, 0xd4, 0x76, 0x61,
+	0x44, 0x2a, 0x44, 0x5a, 0x57, 0xb5, 0xde, 0x17, 0x4c, 0xab, 0x71, 0xd6, 0x56, 0x87, 0x94, 0xd6,
+	0x3f, 0x69, 0xf6, 0x42, 0x54, 0xfe, 0x7e, 0x7c, 0x79, 0xb4, 0xd2, 0xc9, 0xd7, 0x93, 0xcb, 0xa3,
+	0x95, 0x85, 0xf0, 0x88, 0x06, 0x6a, 0xeb, 0x99, 0x09, 0x56, 0xcb, 0x60, 0x21, 0x13, 0x60, 0x20,
+	0xe2, 0x61, 0x97, 0x20, 0xf5, 0x4c, 0x00, 0xa5, 0x6d, 0x62, 0xdd, 0x77, 0xfd, 0xaf, 0x7f, 0x1d,
+	0x7a, 0xc6, 0x6b, 0x68, 0x90, 0x78, 0x55, 0x2a, 0xdd, 0x4e, 0x94, 0x53, 0x4e, 0x64, 0xc9, 0x57,
+	0x97, 0x41, 0xf9, 0x06, 0x08, 0x77, 0xe3, 0xdd, 0x10, 0x50, 0x42, 0xac, 0xd7, 0x80, 0x14, 0xf5,
+	0x7c, 0x6a, 0xdf, 0x81, 0x19, 0x19, 0x39, 0x1c, 0xfe, 0x36, 0x39, 0xfc, 0xa7, 0xdb, 0xfd, 0xc5,
+	0xb4, 0xfb, 0xfd, 0xed, 0x52, 0x97, 0xc0, 0x62, 0x36, 0x82, 0x7b, 0xff, 0x46, 0x00, 0x63, 0x1c,
+	0xba, 0x03, 0x7d, 0xe8, 0x90, 0x81, 0xcd, 0xde, 0x04, 0x79, 0x2f, 0xea, 0xc0, 0xde, 0xbc, 0x4a,
+	0x3f, 0x47, 0xe2, 0x73, 0xae, 0xbc, 0x4a, 0x62, 0x62, 0x65, 0xa3, 0x7b, 0xe6, 0xf9, 0x64, 0xe6,
+	0xc3, 0xd4, 0xe7, 0xe6, 0x9a, 0x64, 0x75, 0x06, 0x4c, 0x5f, 0x2b, 0x25, 0x13, 0xae, 0xbf, 0x1e,
+	0x01, 0xc3, 0xdb, 0xc4, 0x12, 0x9f, 0x09, 0x40, 0xce, 0xf8, 0xfa, 0xfc, 0xd9, 0x4f, 0x6c, 0xe6,
+	0x8d, 0x96, 0xff, 0x1b, 0x88, 0x96, 0x88, 0x13, 0x5f, 0x08, 0x60, 0x36, 0xf3, 0x2d, 0xf0, 0x57,
+	0x46, 0xff, 0x2c, 0xa2, 0x7c, 0x6b, 0x40, 0x22, 0x97, 0xf6, 0x5c, 0x00, 0xbf, 0x66, 0x5e, 0xc9,
+	0xac, 0x03, 0xfa, 0xf3, 0xe4, 0xff, 0x07, 0xe3, 0x71, 0x5d, 0xfb, 0xe0, 0xe7, 0x2b, 0x69, 0x2d,
+	0xdf, 0xd8, 0x2f, 0x06, 0xca, 0xfa, 0x17, 0x02, 0x93, 0x93, 0xe4, 0xd1, 0x47, 0x61, 0x36, 0xab,
+	0xb5, 0xe3, 0x73, 0x45, 0x38, 0x39, 0x57, 0x84, 0xb3, 0x73, 0x45, 0x78, 0x7a, 0xa1, 0xe4, 0x4e,
+	0x2e, 0x94, 0xdc, 0x87, 0x0b, 0x25, 0xf7, 0x60, 0xd9, 0xb2, 0xe9, 0x7e, 0xab, 0xae, 0x99, 0xd8,
+	0xd1, 0x79, 0x4a, 0xc3, 0xff, 0x8b, 0xd2, 0x51, 0xa5, 0x81, 0x87, 0x48, 0x3d, 0x1f, 0x7d, 0x91,
+	0xff, 0xf8, 0x1c, 0x00, 0x00, 0xff, 0xff, 0x66, 0x31, 0x8a, 0xb8, 0x2f, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -322,6 +567,12 @@ type MsgClient interface {
 	RegisterVolunteerValidator(ctx context.Context, in *MsgRegisterVolunteerValidator, opts ...grpc.CallOption) (*MsgRegisterVolunteerValidatorResponse, error)
 	// UnregisterVolunteerValidator defines a method to unregister a volunteer
 	UnregisterVolunteerValidator(ctx context.Context, in *MsgUnregisterVolunteerValidator, opts ...grpc.CallOption) (*MsgUnregisterVolunteerValidatorResponse, error)
+	// UpdateCommissionDestination defines a method to update where the
+	// commission of a volunteer validator is sent.
+	UpdateCommissionDestination(ctx context.Context, in *MsgUpdateCommissionDestination, opts ...grpc.CallOption) (*MsgUpdateCommissionDestinationResponse, error)
+	// UpdateParams defines a governance operation for updating the x/volunteer
+	// module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -350,6 +601,24 @@ func (c *msgClient) UnregisterVolunteerValidator(ctx context.Context, in *MsgUnr
 	return out, nil
 }
 
+func (c *msgClient) UpdateCommissionDestination(ctx context.Context, in *MsgUpdateCommissionDestination, opts ...grpc.CallOption) (*MsgUpdateCommissionDestinationResponse, error) {
+	out := new(MsgUpdateCommissionDestinationResponse)
+	err := c.cc.Invoke(ctx, "/xpla.volunteer.v1beta1.Msg/UpdateCommissionDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/xpla.volunteer.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterVolunteerValidator defines a method to register a new volunteer
@@ -357,6 +626,12 @@ type MsgServer interface {
 	RegisterVolunteerValidator(context.Context, *MsgRegisterVolunteerValidator) (*MsgRegisterVolunteerValidatorResponse, error)
 	// UnregisterVolunteerValidator defines a method to unregister a volunteer
 	UnregisterVolunteerValidator(context.Context, *MsgUnregisterVolunteerValidator) (*MsgUnregisterVolunteerValidatorResponse, error)
+	// UpdateCommissionDestination defines a method to update where the
+	// commission of a volunteer validator is sent.
+	UpdateCommissionDestination(context.Context, *MsgUpdateCommissionDestination) (*MsgUpdateCommissionDestinationResponse, error)
+	// UpdateParams defines a governance operation for updating the x/volunteer
+	// module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -369,6 +644,12 @@ func (*UnimplementedMsgServer) RegisterVolunteerValidator(ctx context.Context, r
 func (*UnimplementedMsgServer) UnregisterVolunteerValidator(ctx context.Context, req *MsgUnregisterVolunteerValidator) (*MsgUnregisterVolunteerValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnregisterVolunteerValidator not implemented")
 }
+func (*UnimplementedMsgServer) UpdateCommissionDestination(ctx context.Context, req *MsgUpdateCommissionDestination) (*MsgUpdateCommissionDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommissionDestination not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -410,6 +691,42 @@ func _Msg_UnregisterVolunteerValidator_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCommissionDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCommissionDestination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCommissionDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.volunteer.v1beta1.Msg/UpdateCommissionDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCommissionDestination(ctx, req.(*MsgUpdateCommissionDestination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xpla.volunteer.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "xpla.volunteer.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -422,6 +739,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnregisterVolunteerValidator",
 			Handler:    _Msg_UnregisterVolunteerValidator_Handler,
 		},
+		{
+			MethodName: "UpdateCommissionDestination",
+			Handler:    _Msg_UpdateCommissionDestination_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xpla/volunteer/v1beta1/tx.proto",
@@ -447,6 +772,30 @@ func (m *MsgRegisterVolunteerValidator) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.CommissionDestination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.OperatorDescription) > 0 {
+		i -= len(m.OperatorDescription)
+		copy(dAtA[i:], m.OperatorDescription)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorDescription)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.OperatorContact) > 0 {
+		i -= len(m.OperatorContact)
+		copy(dAtA[i:], m.OperatorContact)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorContact)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -586,44 +935,187 @@ func (m *MsgUnregisterVolunteerValidatorResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateCommissionDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterVolunteerValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateCommissionDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCommissionDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.CommissionDestination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ValidatorDescription.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pubkey != nil {
-		l = m.Pubkey.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCommissionDestinationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCommissionDestinationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCommissionDestinationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterVolunteerValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ValidatorDescription.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.OperatorContact)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorDescription)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CommissionDestination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
 
 func (m *MsgRegisterVolunteerValidatorResponse) Size() (n int) {
@@ -661,6 +1153,58 @@ func (m *MsgUnregisterVolunteerValidatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateCommissionDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CommissionDestination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCommissionDestinationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -894,6 +1438,103 @@ func (m *MsgRegisterVolunteerValidator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorDescription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionDestination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionDestination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1129,6 +1770,368 @@ func (m *MsgUnregisterVolunteerValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateCommissionDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCommissionDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCommissionDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionDestination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionDestination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCommissionDestinationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCommissionDestinationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCommissionDestinationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,12 +1,66 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxPowerHistoryLength is the number of the latest power changes kept in a
+// volunteer validator.
+const MaxPowerHistoryLength = 100
+
 func NewVolunteerValidator(valAddress sdk.ValAddress, power int64) VolunteerValidator {
 	return VolunteerValidator{
 		Address: valAddress.String(),
 		Power:   power,
 	}
 }
+
+// UpdatePower sets the power of the volunteer validator and records the change
+// in the power history. It returns false if the power is not changed.
+func (v *VolunteerValidator) UpdatePower(height, power int64) bool {
+	if v.Power == power && len(v.PowerHistory) > 0 {
+		return false
+	}
+
+	v.Power = power
+	v.PowerHistory = append(v.PowerHistory, VolunteerValidatorPower{
+		Height: height,
+		Power:  power,
+	})
+
+	if len(v.PowerHistory) > MaxPowerHistoryLength {
+		v.PowerHistory = v.PowerHistory[len(v.PowerHistory)-MaxPowerHistoryLength:]
+	}
+
+	return true
+}
+
+// NewCommissionDestination creates a commission destination. The address is
+// only used for CommissionDestinationAccount.
+func NewCommissionDestination(destinationType CommissionDestinationType, address string) CommissionDestination {
+	return CommissionDestination{
+		Type:    destinationType,
+		Address: address,
+	}
+}
+
+// Validate checks that the address is set only for an account destination.
+func (d CommissionDestination) Validate() error {
+	switch d.Type {
+	case CommissionDestinationCommunityPool, CommissionDestinationRewardPool, CommissionDestinationBurn:
+		if d.Address != "" {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "address must be empty for commission destination %s", d.Type)
+		}
+	case CommissionDestinationAccount:
+		if _, err := sdk.AccAddressFromBech32(d.Address); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid commission destination address: %s", err)
+		}
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown commission destination type: %d", d.Type)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/xpladev/xpla/x/volunteer/types"
+)
+
+func TestVolunteerValidator_UpdatePower(t *testing.T) {
+	validator := types.NewVolunteerValidator(sdk.ValAddress("validator"), 0)
+
+	require.True(t, validator.UpdatePower(1, 0))
+	require.False(t, validator.UpdatePower(2, 0))
+	require.True(t, validator.UpdatePower(3, 10))
+	require.Equal(t, int64(10), validator.Power)
+	require.Equal(t, []types.VolunteerValidatorPower{
+		{Height: 1, Power: 0},
+		{Height: 3, Power: 10},
+	}, validator.PowerHistory)
+
+	for i := int64(0); i < types.MaxPowerHistoryLength; i++ {
+		require.True(t, validator.UpdatePower(4+i, 11+i))
+	}
+	require.Len(t, validator.PowerHistory, types.MaxPowerHistoryLength)
+	require.Equal(t, types.VolunteerValidatorPower{Height: 4, Power: 11}, validator.PowerHistory[0])
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(types.DefaultGenesisState()))
+
+	validator := types.NewVolunteerValidator(sdk.ValAddress("validator"), 0)
+	pendingRegistration := types.PendingRegistration{ValidatorAddress: validator.Address, ProposalId: 1}
+	require.NoError(t, types.ValidateGenesis(types.NewGenesisState([]*types.VolunteerValidator{&validator}, []types.PendingRegistration{pendingRegistration}, types.DefaultParams())))
+
+	invalidPendingRegistration := types.PendingRegistration{ValidatorAddress: "invalid", ProposalId: 1}
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState(nil, []types.PendingRegistration{invalidPendingRegistration}, types.DefaultParams())))
+
+	for i := int64(0); i <= types.MaxPowerHistoryLength; i++ {
+		validator.PowerHistory = append(validator.PowerHistory, types.VolunteerValidatorPower{Height: i, Power: i})
+	}
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState([]*types.VolunteerValidator{&validator}, nil, types.DefaultParams())))
+}
+
+func TestCommissionDestination_Validate(t *testing.T) {
+	account := sdk.AccAddress("account").String()
+
+	tests := []struct {
+		name        string
+		destination types.CommissionDestination
+		wantErr     bool
+	}{
+		{"community pool", types.NewCommissionDestination(types.CommissionDestinationCommunityPool, ""), false},
+		{"reward pool", types.NewCommissionDestination(types.CommissionDestinationRewardPool, ""), false},
+		{"burn", types.NewCommissionDestination(types.CommissionDestinationBurn, ""), false},
+		{"account", types.NewCommissionDestination(types.CommissionDestinationAccount, account), false},
+		{"address with pool", types.NewCommissionDestination(types.CommissionDestinationRewardPool, account), true},
+		{"account without address", types.NewCommissionDestination(types.CommissionDestinationAccount, ""), true},
+		{"account with invalid address", types.NewCommissionDestination(types.CommissionDestinationAccount, "invalid"), true},
+		{"unknown type", types.NewCommissionDestination(types.CommissionDestinationType(4), ""), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.destination.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_TermEndHeight(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, int64(0), params.TermEndHeight(10))
+
+	params.MaxTermBlocks = 5
+	require.Equal(t, int64(15), params.TermEndHeight(10))
+}
+
+func TestParams_Validate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.MaxVolunteerPowerFraction = types.MaxVolunteerPowerFractionLimit
+	require.NoError(t, params.Validate())
+
+	params.MaxVolunteerPowerFraction = sdkmath.LegacyNewDecWithPrec(34, 2)
+	require.Error(t, params.Validate())
+
+	params.MaxVolunteerPowerFraction = sdkmath.LegacyZeroDec()
+	require.Error(t, params.Validate())
+
+	params.MaxVolunteerPowerFraction = sdkmath.LegacyDec{}
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxVolunteerValidators = 0
+	require.Error(t, params.Validate())
+}
+
+func TestParams_MaxVolunteerPower(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxVolunteerPowerFraction = sdkmath.LegacyNewDecWithPrec(2, 1)
+	require.Equal(t, sdkmath.NewInt(25), params.MaxVolunteerPower(100))
+	require.Equal(t, sdkmath.NewInt(0), params.MaxVolunteerPower(0))
+}
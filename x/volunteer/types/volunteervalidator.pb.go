@@ -4,18 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,19 +30,202 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CommissionDestinationType defines where the commission of a volunteer
+// validator is sent.
+type CommissionDestinationType int32
+
+const (
+	// COMMISSION_DESTINATION_TYPE_COMMUNITY_POOL sends the commission to the
+	// community pool.
+	CommissionDestinationCommunityPool CommissionDestinationType = 0
+	// COMMISSION_DESTINATION_TYPE_REWARD_POOL sends the commission to the reward
+	// pool of x/reward. The denoms which the reward pool does not accept are
+	// sent to the community pool.
+	CommissionDestinationRewardPool CommissionDestinationType = 1
+	// COMMISSION_DESTINATION_TYPE_BURN burns the commission through x/burn.
+	CommissionDestinationBurn CommissionDestinationType = 2
+	// COMMISSION_DESTINATION_TYPE_ACCOUNT sends the commission to the account of
+	// the destination address.
+	CommissionDestinationAccount CommissionDestinationType = 3
+)
+
+var CommissionDestinationType_name = map[int32]string{
+	0: "COMMISSION_DESTINATION_TYPE_COMMUNITY_POOL",
+	1: "COMMISSION_DESTINATION_TYPE_REWARD_POOL",
+	2: "COMMISSION_DESTINATION_TYPE_BURN",
+	3: "COMMISSION_DESTINATION_TYPE_ACCOUNT",
+}
+
+var CommissionDestinationType_value = map[string]int32{
+	"COMMISSION_DESTINATION_TYPE_COMMUNITY_POOL": 0,
+	"COMMISSION_DESTINATION_TYPE_REWARD_POOL":    1,
+	"COMMISSION_DESTINATION_TYPE_BURN":           2,
+	"COMMISSION_DESTINATION_TYPE_ACCOUNT":        3,
+}
+
+func (x CommissionDestinationType) String() string {
+	return proto.EnumName(CommissionDestinationType_name, int32(x))
+}
+
+func (CommissionDestinationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_29985b0ee34b89e7, []int{0}
+}
+
+// Params defines the set of params for the volunteer module.
+type Params struct {
+	// max_term_blocks is the number of blocks a volunteer validator stays in the
+	// validator set after its registration. A volunteer validator is
+	// unregistered automatically at the end of its term. Zero means no term
+	// limit.
+	MaxTermBlocks uint64 `protobuf:"varint,1,opt,name=max_term_blocks,json=maxTermBlocks,proto3" json:"max_term_blocks,omitempty"`
+	// max_volunteer_validators is the maximum number of volunteer validators.
+	// Only this many volunteer validators are added to the validator set on top
+	// of the max validators of x/staking.
+	MaxVolunteerValidators uint32 `protobuf:"varint,2,opt,name=max_volunteer_validators,json=maxVolunteerValidators,proto3" json:"max_volunteer_validators,omitempty"`
+	// max_volunteer_power_fraction is the maximum fraction of the total
+	// consensus power held by the volunteer validators. The consensus powers of
+	// the volunteer validators are scaled down to keep their share under it.
+	MaxVolunteerPowerFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_volunteer_power_fraction,json=maxVolunteerPowerFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_volunteer_power_fraction"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29985b0ee34b89e7, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxTermBlocks() uint64 {
+	if m != nil {
+		return m.MaxTermBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVolunteerValidators() uint32 {
+	if m != nil {
+		return m.MaxVolunteerValidators
+	}
+	return 0
+}
+
+// CommissionDestination defines where the commission of a volunteer validator
+// is sent.
+type CommissionDestination struct {
+	// type is the type of the destination.
+	Type CommissionDestinationType `protobuf:"varint,1,opt,name=type,proto3,enum=xpla.volunteer.v1beta1.CommissionDestinationType" json:"type,omitempty"`
+	// address is the account which receives the commission. It is only set for
+	// COMMISSION_DESTINATION_TYPE_ACCOUNT.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *CommissionDestination) Reset()         { *m = CommissionDestination{} }
+func (m *CommissionDestination) String() string { return proto.CompactTextString(m) }
+func (*CommissionDestination) ProtoMessage()    {}
+func (*CommissionDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29985b0ee34b89e7, []int{1}
+}
+func (m *CommissionDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionDestination.Merge(m, src)
+}
+func (m *CommissionDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionDestination proto.InternalMessageInfo
+
+func (m *CommissionDestination) GetType() CommissionDestinationType {
+	if m != nil {
+		return m.Type
+	}
+	return CommissionDestinationCommunityPool
+}
+
+func (m *CommissionDestination) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // VolunteerValidator required for validator set update logic.
 type VolunteerValidator struct {
 	// address is the address of the validator.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// power defines the power of the validator.
 	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// registration_height is the block height at which the validator was
+	// registered.
+	RegistrationHeight int64 `protobuf:"varint,3,opt,name=registration_height,json=registrationHeight,proto3" json:"registration_height,omitempty"`
+	// registration_time is the block time at which the validator was
+	// registered.
+	RegistrationTime time.Time `protobuf:"bytes,4,opt,name=registration_time,json=registrationTime,proto3,stdtime" json:"registration_time"`
+	// proposal_id is the id of the governance proposal which registered the
+	// validator. It is zero if the validator was not registered by a proposal.
+	ProposalId uint64 `protobuf:"varint,5,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// operator_contact is the contact of the validator operator.
+	OperatorContact string `protobuf:"bytes,6,opt,name=operator_contact,json=operatorContact,proto3" json:"operator_contact,omitempty"`
+	// operator_description is the description of the validator operator.
+	OperatorDescription string `protobuf:"bytes,7,opt,name=operator_description,json=operatorDescription,proto3" json:"operator_description,omitempty"`
+	// power_history defines the latest power changes of the validator.
+	PowerHistory []VolunteerValidatorPower `protobuf:"bytes,8,rep,name=power_history,json=powerHistory,proto3" json:"power_history"`
+	// commission_destination defines where the commission of the validator is
+	// sent.
+	CommissionDestination CommissionDestination `protobuf:"bytes,9,opt,name=commission_destination,json=commissionDestination,proto3" json:"commission_destination"`
+	// term_end_height is the block height at which the validator is
+	// unregistered. Zero means no term limit.
+	TermEndHeight int64 `protobuf:"varint,10,opt,name=term_end_height,json=termEndHeight,proto3" json:"term_end_height,omitempty"`
 }
 
 func (m *VolunteerValidator) Reset()         { *m = VolunteerValidator{} }
 func (m *VolunteerValidator) String() string { return proto.CompactTextString(m) }
 func (*VolunteerValidator) ProtoMessage()    {}
 func (*VolunteerValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29985b0ee34b89e7, []int{0}
+	return fileDescriptor_29985b0ee34b89e7, []int{2}
 }
 func (m *VolunteerValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -64,8 +254,68 @@ func (m *VolunteerValidator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VolunteerValidator proto.InternalMessageInfo
 
+// VolunteerValidatorPower defines the power of a volunteer validator from a
+// block height.
+type VolunteerValidatorPower struct {
+	// height is the block height at which the power changed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// power defines the power of the validator.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *VolunteerValidatorPower) Reset()         { *m = VolunteerValidatorPower{} }
+func (m *VolunteerValidatorPower) String() string { return proto.CompactTextString(m) }
+func (*VolunteerValidatorPower) ProtoMessage()    {}
+func (*VolunteerValidatorPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29985b0ee34b89e7, []int{3}
+}
+func (m *VolunteerValidatorPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolunteerValidatorPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolunteerValidatorPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VolunteerValidatorPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolunteerValidatorPower.Merge(m, src)
+}
+func (m *VolunteerValidatorPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolunteerValidatorPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolunteerValidatorPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolunteerValidatorPower proto.InternalMessageInfo
+
+func (m *VolunteerValidatorPower) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *VolunteerValidatorPower) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("xpla.volunteer.v1beta1.CommissionDestinationType", CommissionDestinationType_name, CommissionDestinationType_value)
+	proto.RegisterType((*Params)(nil), "xpla.volunteer.v1beta1.Params")
+	proto.RegisterType((*CommissionDestination)(nil), "xpla.volunteer.v1beta1.CommissionDestination")
 	proto.RegisterType((*VolunteerValidator)(nil), "xpla.volunteer.v1beta1.VolunteerValidator")
+	proto.RegisterType((*VolunteerValidatorPower)(nil), "xpla.volunteer.v1beta1.VolunteerValidatorPower")
 }
 
 func init() {
@@ -73,20 +323,140 @@ func init() {
 }
 
 var fileDescriptor_29985b0ee34b89e7 = []byte{
-	// 206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xaf, 0x28, 0xc8, 0x49,
-	0xd4, 0x2f, 0xcb, 0xcf, 0x29, 0xcd, 0x2b, 0x49, 0x4d, 0x2d, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0x44, 0x88, 0x94, 0x25, 0xe6, 0x64, 0xa6, 0x24, 0x96, 0xe4, 0x17, 0xe9, 0x15, 0x14,
-	0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x81, 0x34, 0xe8, 0xc1, 0xa5, 0xf5, 0xa0, 0x1a, 0xa4, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0xc1, 0x4a, 0xf4, 0x41, 0x2c, 0x88, 0x6a, 0x25, 0x3f, 0x2e, 0xa1, 0x30, 0x98,
-	0xd2, 0x30, 0x98, 0x49, 0x42, 0x12, 0x5c, 0xec, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x41, 0x7e, 0x79, 0x6a,
-	0x91, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x73, 0x10, 0x84, 0x63, 0xc5, 0xd1, 0xb1, 0x40, 0x9e, 0xe1,
-	0xc5, 0x02, 0x79, 0x06, 0x27, 0xe7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
-	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88,
-	0xd2, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0x05, 0xfb, 0x29, 0x25, 0xb5,
-	0x0c, 0xe2, 0xb7, 0x0a, 0x24, 0xdf, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xdd, 0x66,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xe9, 0xaa, 0xd8, 0xf0, 0xfc, 0x00, 0x00, 0x00,
+	// 872 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x2d, 0xc5, 0x89, 0xce, 0x55, 0xad, 0x5c, 0x1c, 0x97, 0x66, 0x53, 0x91, 0x50, 0x00,
+	0x57, 0x31, 0x60, 0x12, 0x76, 0x81, 0xa2, 0xc8, 0xa6, 0x5f, 0x6d, 0x04, 0x24, 0x92, 0x40, 0xcb,
+	0x2e, 0xd2, 0x85, 0x38, 0x91, 0x17, 0x8a, 0x88, 0xc8, 0x23, 0x8e, 0x27, 0x5b, 0xda, 0x3b, 0x04,
+	0x9a, 0x82, 0xee, 0x06, 0x02, 0x74, 0xe9, 0x98, 0x21, 0x7f, 0x44, 0x46, 0x23, 0x53, 0xd1, 0x02,
+	0x69, 0x61, 0x0f, 0xe9, 0x9f, 0x51, 0xdc, 0x9d, 0x28, 0x2b, 0x88, 0x6c, 0x20, 0x8b, 0xc0, 0x7b,
+	0xdf, 0x7b, 0x1f, 0xdf, 0xf7, 0xde, 0xc7, 0x13, 0xb0, 0xc6, 0xf1, 0x10, 0x59, 0xc7, 0x64, 0x38,
+	0x8a, 0x18, 0xc6, 0xd4, 0x3a, 0xde, 0xeb, 0x63, 0x86, 0xf6, 0x2e, 0x23, 0xc7, 0x68, 0x18, 0x78,
+	0x88, 0x11, 0x6a, 0xc6, 0x94, 0x30, 0x02, 0x37, 0x79, 0x81, 0x39, 0x87, 0xcd, 0x59, 0x81, 0xb6,
+	0xe1, 0x13, 0x9f, 0x88, 0x14, 0x8b, 0x3f, 0xc9, 0x6c, 0x4d, 0xf7, 0x09, 0xf1, 0x87, 0xd8, 0x12,
+	0xa7, 0xfe, 0xe8, 0x99, 0xc5, 0x82, 0x10, 0x27, 0x0c, 0x85, 0xf1, 0x2c, 0xe1, 0x36, 0x0a, 0x83,
+	0x88, 0x58, 0xe2, 0x77, 0x16, 0xda, 0x72, 0x49, 0x12, 0x92, 0xc4, 0x91, 0x64, 0xf2, 0x20, 0xa1,
+	0xf2, 0xaf, 0x2b, 0x60, 0xb5, 0x8b, 0x28, 0x0a, 0x13, 0xb8, 0x0d, 0xd6, 0x43, 0x34, 0x76, 0x18,
+	0xa6, 0xa1, 0xd3, 0x1f, 0x12, 0xf7, 0x79, 0xa2, 0x2a, 0x86, 0x52, 0xc9, 0xd9, 0x85, 0x10, 0x8d,
+	0x7b, 0x98, 0x86, 0x35, 0x11, 0x84, 0x3f, 0x00, 0x95, 0xe7, 0xcd, 0x1b, 0x76, 0xe6, 0x82, 0x12,
+	0x75, 0xc5, 0x50, 0x2a, 0x05, 0x7b, 0x33, 0x44, 0xe3, 0xa3, 0x14, 0x3e, 0x9a, 0xa3, 0xf0, 0x04,
+	0xdc, 0xfb, 0xb8, 0x32, 0x26, 0x27, 0x98, 0x3a, 0xcf, 0x28, 0x72, 0x59, 0x40, 0x22, 0x35, 0x6b,
+	0x28, 0x95, 0x7c, 0xed, 0xfb, 0xb7, 0xef, 0xf5, 0xcc, 0x5f, 0xef, 0xf5, 0xaf, 0x65, 0xa3, 0x89,
+	0xf7, 0xdc, 0x0c, 0x88, 0x15, 0x22, 0x36, 0x30, 0x1f, 0x63, 0x1f, 0xb9, 0x93, 0x06, 0x76, 0xdf,
+	0xbd, 0xd9, 0x05, 0x33, 0x1d, 0x0d, 0xec, 0xfe, 0xf1, 0xe1, 0xf5, 0x8e, 0x62, 0x6f, 0x2d, 0xbe,
+	0xb5, 0xcb, 0x99, 0x7f, 0x9c, 0x11, 0x3f, 0xd4, 0xa7, 0x1f, 0x5e, 0xef, 0x68, 0x7c, 0xce, 0x1e,
+	0x3e, 0xb6, 0xc6, 0x0b, 0xdb, 0x91, 0xda, 0xcb, 0xbf, 0x29, 0xe0, 0x6e, 0x9d, 0x84, 0x61, 0x90,
+	0x24, 0x01, 0x89, 0x1a, 0x38, 0x61, 0x41, 0x84, 0x78, 0x29, 0x6c, 0x82, 0x1c, 0x9b, 0xc4, 0x58,
+	0x8c, 0xe2, 0xcb, 0xfd, 0x3d, 0x73, 0xf9, 0xb2, 0xcc, 0xa5, 0xc5, 0xbd, 0x49, 0x8c, 0x6d, 0x51,
+	0x0e, 0xf7, 0xc1, 0x4d, 0xe4, 0x79, 0x14, 0x27, 0x72, 0x46, 0xf9, 0x9a, 0xfa, 0xee, 0xcd, 0xee,
+	0xc6, 0x4c, 0x42, 0x55, 0x22, 0x07, 0x8c, 0x06, 0x91, 0x6f, 0xa7, 0x89, 0xe5, 0xb3, 0x1c, 0x80,
+	0x9f, 0x8e, 0x11, 0xaa, 0x97, 0x54, 0xbc, 0xa9, 0xfc, 0xbc, 0x00, 0x6e, 0x80, 0x1b, 0x62, 0xa2,
+	0xe2, 0x15, 0x59, 0x5b, 0x1e, 0xa0, 0x05, 0xee, 0x50, 0xec, 0x07, 0x09, 0xa3, 0xa2, 0x29, 0x67,
+	0x80, 0x03, 0x7f, 0xc0, 0xc4, 0xb0, 0xb3, 0x36, 0x5c, 0x84, 0x1e, 0x09, 0x04, 0x1e, 0x81, 0xdb,
+	0x1f, 0x15, 0x70, 0x87, 0xa9, 0x39, 0x43, 0xa9, 0xac, 0xed, 0x6b, 0xa6, 0xb4, 0x9f, 0x99, 0xda,
+	0xcf, 0xec, 0xa5, 0xf6, 0xab, 0x15, 0xf8, 0xde, 0x5e, 0xfe, 0xa3, 0x2b, 0x72, 0x1d, 0xc5, 0x45,
+	0x0e, 0x9e, 0x05, 0x75, 0xb0, 0x16, 0x53, 0x12, 0x93, 0x04, 0x0d, 0x9d, 0xc0, 0x53, 0x6f, 0x08,
+	0x73, 0x81, 0x34, 0xd4, 0xf2, 0xe0, 0x03, 0x50, 0x24, 0x31, 0xa6, 0x5c, 0xa5, 0xe3, 0x92, 0x88,
+	0x21, 0x97, 0xa9, 0xab, 0x42, 0xe2, 0x7a, 0x1a, 0xaf, 0xcb, 0x30, 0xdc, 0x03, 0x1b, 0xf3, 0x54,
+	0x0f, 0x27, 0x2e, 0x0d, 0x62, 0x61, 0xa1, 0x9b, 0x22, 0xfd, 0x4e, 0x8a, 0x35, 0x2e, 0x21, 0xe8,
+	0x80, 0x82, 0xf4, 0xdb, 0x20, 0x48, 0x18, 0xa1, 0x13, 0xf5, 0x96, 0x91, 0xad, 0xac, 0xed, 0x5b,
+	0x57, 0xad, 0xf4, 0xd3, 0xd1, 0x0b, 0x53, 0xd5, 0xf2, 0x5c, 0xa7, 0xd4, 0xf8, 0x85, 0x20, 0x7c,
+	0x24, 0xf9, 0x20, 0x01, 0x9b, 0xee, 0xdc, 0x06, 0xbc, 0xab, 0xd4, 0x07, 0x6a, 0x5e, 0x0c, 0x6f,
+	0xf7, 0xb3, 0xcc, 0xb3, 0xf8, 0x9e, 0xbb, 0xee, 0x52, 0x6f, 0x6e, 0x83, 0x75, 0xf1, 0xb5, 0xe2,
+	0xc8, 0x4b, 0xb7, 0x0a, 0xc4, 0x56, 0x0b, 0x3c, 0xdc, 0x8c, 0x3c, 0xb9, 0xd0, 0x87, 0xb7, 0x5e,
+	0xbc, 0xd2, 0x33, 0xff, 0xbd, 0xd2, 0x33, 0xe5, 0x9f, 0xc0, 0x57, 0x57, 0xc8, 0x82, 0x9b, 0x60,
+	0x75, 0xc6, 0xa1, 0x08, 0x8e, 0xd9, 0x69, 0xb9, 0xa9, 0x76, 0xfe, 0x5e, 0x01, 0x5b, 0x57, 0x7a,
+	0x1e, 0x1e, 0x81, 0x9d, 0x7a, 0xe7, 0xc9, 0x93, 0xd6, 0xc1, 0x41, 0xab, 0xd3, 0x76, 0x1a, 0xcd,
+	0x83, 0x5e, 0xab, 0x5d, 0xed, 0xf1, 0xe7, 0xde, 0xd3, 0x6e, 0xd3, 0xe1, 0xd8, 0x61, 0xbb, 0xd5,
+	0x7b, 0xea, 0x74, 0x3b, 0x9d, 0xc7, 0xc5, 0x8c, 0xb6, 0x3d, 0x3d, 0x35, 0xca, 0x4b, 0xe9, 0x78,
+	0x70, 0x14, 0x05, 0x6c, 0xd2, 0x25, 0x64, 0x08, 0xbb, 0xe0, 0xdb, 0xeb, 0x78, 0xed, 0xe6, 0xcf,
+	0x55, 0xbb, 0x21, 0x49, 0x15, 0xed, 0xfe, 0xf4, 0xd4, 0xd0, 0x97, 0x92, 0xda, 0xf8, 0x04, 0x51,
+	0x4f, 0x30, 0xd6, 0x81, 0x71, 0x1d, 0x63, 0xed, 0xd0, 0x6e, 0x17, 0x57, 0xb4, 0x6f, 0xa6, 0xa7,
+	0xc6, 0x72, 0xb9, 0xb5, 0x11, 0x8d, 0x60, 0x0b, 0xdc, 0xbf, 0x8e, 0xa4, 0x5a, 0xaf, 0x77, 0x0e,
+	0xdb, 0xbd, 0x62, 0x56, 0x33, 0xa6, 0xa7, 0xc6, 0xbd, 0xa5, 0x3c, 0x55, 0xd7, 0x25, 0xa3, 0x88,
+	0x69, 0xb9, 0x17, 0xbf, 0x97, 0x32, 0xb5, 0xfa, 0xdb, 0xf3, 0x92, 0x72, 0x76, 0x5e, 0x52, 0xfe,
+	0x3d, 0x2f, 0x29, 0x2f, 0x2f, 0x4a, 0x99, 0xb3, 0x8b, 0x52, 0xe6, 0xcf, 0x8b, 0x52, 0xe6, 0x97,
+	0x07, 0x7e, 0xc0, 0x06, 0xa3, 0xbe, 0xe9, 0x92, 0xd0, 0x9a, 0xdf, 0x67, 0xfc, 0x0f, 0x67, 0xf1,
+	0x52, 0xe3, 0x37, 0x4e, 0xd2, 0x5f, 0x15, 0xdf, 0xe8, 0x77, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff,
+	0x95, 0xb4, 0x77, 0x71, 0x91, 0x06, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxVolunteerPowerFraction.Size()
+		i -= size
+		if _, err := m.MaxVolunteerPowerFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxVolunteerValidators != 0 {
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(m.MaxVolunteerValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxTermBlocks != 0 {
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(m.MaxTermBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *VolunteerValidator) Marshal() (dAtA []byte, err error) {
@@ -109,6 +479,67 @@ func (m *VolunteerValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TermEndHeight != 0 {
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(m.TermEndHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.CommissionDestination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.PowerHistory) > 0 {
+		for iNdEx := len(m.PowerHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PowerHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVolunteervalidator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.OperatorDescription) > 0 {
+		i -= len(m.OperatorDescription)
+		copy(dAtA[i:], m.OperatorDescription)
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(len(m.OperatorDescription)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OperatorContact) > 0 {
+		i -= len(m.OperatorContact)
+		copy(dAtA[i:], m.OperatorContact)
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(len(m.OperatorContact)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RegistrationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RegistrationTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintVolunteervalidator(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.RegistrationHeight != 0 {
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(m.RegistrationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Power != 0 {
 		i = encodeVarintVolunteervalidator(dAtA, i, uint64(m.Power))
 		i--
@@ -124,6 +555,39 @@ func (m *VolunteerValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VolunteerValidatorPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolunteerValidatorPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolunteerValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintVolunteervalidator(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVolunteervalidator(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVolunteervalidator(v)
 	base := offset
@@ -135,6 +599,39 @@ func encodeVarintVolunteervalidator(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTermBlocks != 0 {
+		n += 1 + sovVolunteervalidator(uint64(m.MaxTermBlocks))
+	}
+	if m.MaxVolunteerValidators != 0 {
+		n += 1 + sovVolunteervalidator(uint64(m.MaxVolunteerValidators))
+	}
+	l = m.MaxVolunteerPowerFraction.Size()
+	n += 1 + l + sovVolunteervalidator(uint64(l))
+	return n
+}
+
+func (m *CommissionDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovVolunteervalidator(uint64(m.Type))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVolunteervalidator(uint64(l))
+	}
+	return n
+}
+
 func (m *VolunteerValidator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -148,6 +645,48 @@ func (m *VolunteerValidator) Size() (n int) {
 	if m.Power != 0 {
 		n += 1 + sovVolunteervalidator(uint64(m.Power))
 	}
+	if m.RegistrationHeight != 0 {
+		n += 1 + sovVolunteervalidator(uint64(m.RegistrationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RegistrationTime)
+	n += 1 + l + sovVolunteervalidator(uint64(l))
+	if m.ProposalId != 0 {
+		n += 1 + sovVolunteervalidator(uint64(m.ProposalId))
+	}
+	l = len(m.OperatorContact)
+	if l > 0 {
+		n += 1 + l + sovVolunteervalidator(uint64(l))
+	}
+	l = len(m.OperatorDescription)
+	if l > 0 {
+		n += 1 + l + sovVolunteervalidator(uint64(l))
+	}
+	if len(m.PowerHistory) > 0 {
+		for _, e := range m.PowerHistory {
+			l = e.Size()
+			n += 1 + l + sovVolunteervalidator(uint64(l))
+		}
+	}
+	l = m.CommissionDestination.Size()
+	n += 1 + l + sovVolunteervalidator(uint64(l))
+	if m.TermEndHeight != 0 {
+		n += 1 + sovVolunteervalidator(uint64(m.TermEndHeight))
+	}
+	return n
+}
+
+func (m *VolunteerValidatorPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovVolunteervalidator(uint64(m.Height))
+	}
+	if m.Power != 0 {
+		n += 1 + sovVolunteervalidator(uint64(m.Power))
+	}
 	return n
 }
 
@@ -157,7 +696,7 @@ func sovVolunteervalidator(x uint64) (n int) {
 func sozVolunteervalidator(x uint64) (n int) {
 	return sovVolunteervalidator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *VolunteerValidator) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -180,17 +719,17 @@ func (m *VolunteerValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VolunteerValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VolunteerValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTermBlocks", wireType)
 			}
-			var stringLen uint64
+			m.MaxTermBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVolunteervalidator
@@ -200,14 +739,237 @@ func (m *VolunteerValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxTermBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthVolunteervalidator
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVolunteerValidators", wireType)
+			}
+			m.MaxVolunteerValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVolunteerValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVolunteerPowerFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVolunteerPowerFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVolunteervalidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVolunteervalidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= CommissionDestinationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVolunteervalidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolunteerValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVolunteervalidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolunteerValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolunteerValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolunteervalidator
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
@@ -237,6 +999,315 @@ func (m *VolunteerValidator) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationHeight", wireType)
+			}
+			m.RegistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RegistrationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorDescription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerHistory = append(m.PowerHistory, VolunteerValidatorPower{})
+			if err := m.PowerHistory[len(m.PowerHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionDestination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionDestination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermEndHeight", wireType)
+			}
+			m.TermEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TermEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVolunteervalidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVolunteervalidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolunteerValidatorPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVolunteervalidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolunteerValidatorPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolunteerValidatorPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolunteervalidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVolunteervalidator(dAtA[iNdEx:])